@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	stscreds_sdkv2 "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// chainAssumeRoles assumes each IAM Role in turn, using the credentials obtained from the previous role.
+// The first role is assumed using the credentials of the specified configuration.
+func chainAssumeRoles(ctx context.Context, cfg aws_sdkv2.Config, assumeRoles []*awsbase.AssumeRole, stsEndpoint, stsRegion string) (aws_sdkv2.CredentialsProvider, error) {
+	credentialsProvider := cfg.Credentials
+
+	for _, ar := range assumeRoles {
+		tflog.Info(ctx, "Assuming chained IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        ar.RoleARN,
+			"tf_aws.assume_role.session_name":    ar.SessionName,
+			"tf_aws.assume_role.external_id":     ar.ExternalID,
+			"tf_aws.assume_role.source_identity": ar.SourceIdentity,
+		})
+
+		cfg.Credentials = credentialsProvider
+		client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+			if stsEndpoint != "" {
+				o.BaseEndpoint = aws_sdkv2.String(stsEndpoint)
+			}
+			if stsRegion != "" {
+				o.Region = stsRegion
+			}
+		})
+
+		provider := stscreds_sdkv2.NewAssumeRoleProvider(client, ar.RoleARN, assumeRoleOptions(ar))
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+		}
+
+		credentialsProvider = aws_sdkv2.NewCredentialsCache(provider)
+	}
+
+	return credentialsProvider, nil
+}
+
+func assumeRoleOptions(ar *awsbase.AssumeRole) func(*stscreds_sdkv2.AssumeRoleOptions) {
+	return func(opts *stscreds_sdkv2.AssumeRoleOptions) {
+		opts.RoleSessionName = ar.SessionName
+		opts.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			opts.ExternalID = aws_sdkv2.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			opts.Policy = aws_sdkv2.String(ar.Policy)
+		}
+
+		for _, v := range ar.PolicyARNs {
+			opts.PolicyARNs = append(opts.PolicyARNs, ststypes_sdkv2.PolicyDescriptorType{
+				Arn: aws_sdkv2.String(v),
+			})
+		}
+
+		for k, v := range ar.Tags {
+			opts.Tags = append(opts.Tags, ststypes_sdkv2.Tag{
+				Key:   aws_sdkv2.String(k),
+				Value: aws_sdkv2.String(v),
+			})
+		}
+
+		if len(ar.TransitiveTagKeys) > 0 {
+			opts.TransitiveTagKeys = ar.TransitiveTagKeys
+		}
+
+		if ar.SourceIdentity != "" {
+			opts.SourceIdentity = aws_sdkv2.String(ar.SourceIdentity)
+		}
+	}
+}
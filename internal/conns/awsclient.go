@@ -13,13 +13,18 @@ import (
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	stscreds_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
+	ec2_sdkv1 "github.com/aws/aws-sdk-go/service/ec2"
 	efs_sdkv1 "github.com/aws/aws-sdk-go/service/efs"
+	health_sdkv1 "github.com/aws/aws-sdk-go/service/health"
+	macie2_sdkv1 "github.com/aws/aws-sdk-go/service/macie2"
 	opsworks_sdkv1 "github.com/aws/aws-sdk-go/service/opsworks"
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
@@ -46,8 +51,10 @@ type AWSClient struct {
 	logger                    baselogging.Logger
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
+	s3UsePathStyle            bool                          // From provider configuration.
+	s3USEast1RegionalEndpoint string                        // From provider configuration.
+	serviceRetryConfigs       map[string]ServiceRetryConfig // From provider configuration.
+	standardRetryerOptions    func(*retry_sdkv2.StandardOptions)
 	stsRegion                 string // From provider configuration.
 }
 
@@ -73,6 +80,21 @@ func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directo
 	return directoryservice_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// EC2ConnForRegionAndRole returns an AWS SDK For Go v1 EC2 API client for the specified AWS Region.
+// If an IAM role ARN is specified the client uses credentials obtained by assuming that role.
+// If the specified region is not the default or a role is specified a new "simple" client is created.
+// This new client does not use any configured endpoint override.
+func (c *AWSClient) EC2ConnForRegionAndRole(ctx context.Context, region, roleARN string) *ec2_sdkv1.EC2 {
+	if region == c.Region && roleARN == "" {
+		return c.EC2Conn(ctx)
+	}
+	config := aws_sdkv1.NewConfig().WithRegion(region)
+	if roleARN != "" {
+		config = config.WithCredentials(stscreds_sdkv1.NewCredentials(c.session, roleARN))
+	}
+	return ec2_sdkv1.New(c.session, config)
+}
+
 // EFSConnForRegion returns an AWS SDK For Go v1 EFS API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
@@ -83,6 +105,22 @@ func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sd
 	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// HealthConn returns an AWS SDK For Go v1 Health API client.
+// The Health API is not regionalized; requests are sent to the partition's global endpoint.
+func (c *AWSClient) HealthConn(context.Context) *health_sdkv1.Health {
+	return health_sdkv1.New(c.session)
+}
+
+// Macie2ConnForRegion returns an AWS SDK For Go v1 Macie2 API client for the specified AWS Region.
+// If the specified region is not the default a new "simple" client is created.
+// This new client does not use any configured endpoint override.
+func (c *AWSClient) Macie2ConnForRegion(ctx context.Context, region string) *macie2_sdkv1.Macie2 {
+	if region == c.Region {
+		return c.Macie2Conn(ctx)
+	}
+	return macie2_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
+}
+
 // OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
 // If the specified region is not the default a new "simple" client is created.
 // This new client does not use any configured endpoint override.
@@ -284,9 +322,53 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	if v, ok := c.serviceRetryConfigs[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = c.awsConfigWithRetry(v)
+		if v.MaxAttempts > 0 {
+			// AWS SDK for Go v1 counts retries, not attempts.
+			m["session"] = c.session.Copy(&aws_sdkv1.Config{MaxRetries: aws_sdkv1.Int(max(v.MaxAttempts-1, 0))})
+		}
+	}
+
 	return m
 }
 
+// awsConfigWithRetry returns a copy of the AWS SDK for Go v2 configuration with the specified retry configuration applied.
+func (c *AWSClient) awsConfigWithRetry(v ServiceRetryConfig) *aws_sdkv2.Config {
+	cfg := c.awsConfig.Copy()
+
+	if v.MaxAttempts > 0 {
+		// Service clients wrap the configured Retryer with the maximum number of attempts.
+		cfg.RetryMaxAttempts = v.MaxAttempts
+	}
+
+	mode := cfg.RetryMode
+	if mode == "" {
+		mode = aws_sdkv2.RetryModeStandard
+	}
+
+	if v.Mode != "" && v.Mode != mode {
+		cfg.RetryMode = v.Mode
+		cfg.Retryer = func() aws_sdkv2.Retryer {
+			switch v.Mode {
+			case aws_sdkv2.RetryModeAdaptive:
+				return retry_sdkv2.NewAdaptiveMode(func(o *retry_sdkv2.AdaptiveModeOptions) {
+					if c.standardRetryerOptions != nil {
+						o.StandardOptions = append(o.StandardOptions, c.standardRetryerOptions)
+					}
+				})
+			default:
+				if c.standardRetryerOptions != nil {
+					return retry_sdkv2.NewStandard(c.standardRetryerOptions)
+				}
+				return retry_sdkv2.NewStandard()
+			}
+		}
+	}
+
+	return &cfg
+}
+
 func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName string) string {
 	endpoint := c.endpoints[servicePackageName]
 	if endpoint != "" {
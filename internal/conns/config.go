@@ -10,6 +10,8 @@ import (
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ratelimit_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
 	imds_sdkv2 "github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -29,7 +31,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	AssumeRole                     []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
@@ -50,6 +52,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceRetryConfigs            map[string]ServiceRetryConfig
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -64,6 +67,12 @@ type Config struct {
 	UseFIPSEndpoint                bool
 }
 
+// ServiceRetryConfig overrides the provider's retry configuration for a single service's API clients.
+type ServiceRetryConfig struct {
+	MaxAttempts int
+	Mode        aws_sdkv2.RetryMode
+}
+
 // ConfigureProvider configures the provided provider Meta (instance data).
 func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
@@ -114,8 +123,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		UseFIPSEndpoint:                c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	// Only the first IAM Role is assumed by aws-sdk-go-base; any further roles are chained below.
+	var assumeRoles []*awsbase.AssumeRole
+	for _, v := range c.AssumeRole {
+		if v != nil && v.RoleARN != "" {
+			assumeRoles = append(assumeRoles, v)
+		}
+	}
+
+	if len(assumeRoles) > 0 {
+		awsbaseConfig.AssumeRole = assumeRoles[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -159,6 +176,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if len(assumeRoles) > 1 {
+		tflog.Debug(ctx, "Chaining assumed IAM Roles")
+		credentialsProvider, err := chainAssumeRoles(ctx, cfg, assumeRoles[1:], c.Endpoints[names.STS], c.STSRegion)
+
+		if err != nil {
+			return nil, append(diags, errs.NewErrorDiagnostic("Cannot assume IAM Role", err.Error()))
+		}
+
+		cfg.Credentials = credentialsProvider
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -226,6 +254,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceRetryConfigs = c.ServiceRetryConfigs
+	client.standardRetryerOptions = func(o *retry_sdkv2.StandardOptions) {
+		o.Backoff = awsbaseConfig.Backoff
+		o.MaxBackoff = awsbaseConfig.MaxBackoff
+		if v := c.TokenBucketRateLimiterCapacity; v > 0 {
+			o.RateLimiter = ratelimit_sdkv2.NewTokenRateLimit(uint(v))
+		} else {
+			o.RateLimiter = ratelimit_sdkv2.None
+		}
+	}
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -508,3 +508,122 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestAssumeRoleChain(t *testing.T) {
+	const (
+		chainedRoleARN = "arn:aws:iam::666666666666:role/ChainedRole"
+	)
+
+	cases := map[string]struct {
+		assumeRoles           []any
+		mockStsEndpoints      []*servicemocks.MockEndpoint
+		expectedErrorSummary  string
+		expectedCredentialKey string
+	}{
+		"single role": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			mockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			expectedCredentialKey: servicemocks.MockStsAssumeRoleAccessKey,
+		},
+
+		"chained roles": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     chainedRoleARN,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			mockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"RoleArn": chainedRoleARN,
+				}),
+			},
+			expectedCredentialKey: servicemocks.MockStsAssumeRoleAccessKey,
+		},
+
+		"chained role cannot be assumed": {
+			assumeRoles: []any{
+				map[string]any{
+					"role_arn":     servicemocks.MockStsAssumeRoleArn,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+				map[string]any{
+					"role_arn":     chainedRoleARN,
+					"session_name": servicemocks.MockStsAssumeRoleSessionName,
+				},
+			},
+			mockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+			},
+			expectedErrorSummary: "Cannot assume IAM Role",
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+
+			ts := servicemocks.MockAwsApiServer("STS", tc.mockStsEndpoints)
+			defer ts.Close()
+
+			config := map[string]any{
+				"access_key":                  servicemocks.MockStaticAccessKey,
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-west-2",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+				"assume_role":                 tc.assumeRoles,
+				"endpoints": []any{
+					map[string]any{
+						"sts": ts.URL,
+					},
+				},
+			}
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			if tc.expectedErrorSummary != "" {
+				if !diags.HasError() {
+					t.Fatalf("expected error %q, got none", tc.expectedErrorSummary)
+				}
+				if got, want := diags[len(diags)-1].Summary, tc.expectedErrorSummary; got != want {
+					t.Errorf("expected error %q, got %q", want, got)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			credentials, err := meta.AwsConfig(ctx).Credentials.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error retrieving credentials: %s", err)
+			}
+
+			if got, want := credentials.AccessKeyID, tc.expectedCredentialKey; got != want {
+				t.Errorf("expected access key %q, got %q", want, got)
+			}
+		})
+	}
+}
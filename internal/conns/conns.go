@@ -5,6 +5,7 @@ package conns
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
@@ -19,6 +20,10 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// AsyncWaiter waits for a resource created with `async_create = true` to be ready.
+// Service packages that support `async_create` return their waiters, keyed by resource type, from an AsyncWaiters method.
+type AsyncWaiter func(ctx context.Context, client *AWSClient, id string, timeout time.Duration) error
+
 type (
 	contextKeyType int
 )
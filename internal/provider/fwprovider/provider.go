@@ -60,6 +60,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"default_tags_propagation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether provider default tags applied to Auto Scaling Groups are propagated to the EC2 instances they launch. Defaults to `true`.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -167,9 +171,6 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 		},
 		Blocks: map[string]schema.Block{
 			"assume_role": schema.ListNestedBlock{
-				Validators: []validator.List{
-					listvalidator.SizeAtMost(1),
-				},
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"duration": schema.StringAttribute{
@@ -288,6 +289,45 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"retry": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to retry AWS API requests.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The maximum number of times an AWS API request is being executed.",
+						},
+						"mode": schema.StringAttribute{
+							Optional:    true,
+							Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"service": schema.SetNestedBlock{
+							Description: "Configuration blocks with settings to retry the AWS API requests of a single service.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"max_attempts": schema.Int64Attribute{
+										Optional:    true,
+										Description: "The maximum number of times an AWS API request to the service is being executed.",
+									},
+									"mode": schema.StringAttribute{
+										Optional:    true,
+										Description: "Specifies how retries of AWS API requests to the service are attempted. Valid values are `standard` and `adaptive`.",
+									},
+									"name": schema.StringAttribute{
+										Required:    true,
+										Description: "Name of the service, as used in the `endpoints` configuration block, e.g. `ec2` or `iam`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
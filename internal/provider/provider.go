@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -74,6 +75,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"default_tags_propagation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether provider default tags applied to Auto Scaling Groups are propagated to the EC2 instances they launch. Defaults to `true`.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -158,6 +164,52 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to retry AWS API requests.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:          schema.TypeInt,
+							Optional:      true,
+							ConflictsWith: []string{"max_retries"},
+							Description:   "The maximum number of times an AWS API request is being executed.",
+						},
+						"mode": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"retry_mode"},
+							Description:   "Specifies how retries are attempted. Valid values are `standard` and `adaptive`.",
+						},
+						"service": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Configuration blocks with settings to retry the AWS API requests of a single service.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_attempts": {
+										Type:        schema.TypeInt,
+										Optional:    true,
+										Description: "The maximum number of times an AWS API request to the service is being executed.",
+									},
+									"mode": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Specifies how retries of AWS API requests to the service are attempted. Valid values are `standard` and `adaptive`.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the service, as used in the `endpoints` configuration block, e.g. `ec2` or `iam`.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -514,14 +566,23 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
-	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
-		tflog.Info(ctx, "assume_role configuration set", map[string]any{
-			"tf_aws.assume_role.role_arn":        config.AssumeRole.RoleARN,
-			"tf_aws.assume_role.session_name":    config.AssumeRole.SessionName,
-			"tf_aws.assume_role.external_id":     config.AssumeRole.ExternalID,
-			"tf_aws.assume_role.source_identity": config.AssumeRole.SourceIdentity,
-		})
+	if v, ok := d.GetOk("assume_role"); ok {
+		for _, tfMapRaw := range v.([]interface{}) {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			assumeRole := expandAssumeRole(ctx, tfMap)
+			config.AssumeRole = append(config.AssumeRole, assumeRole)
+			tflog.Info(ctx, "assume_role configuration set", map[string]any{
+				"tf_aws.assume_role.index":           len(config.AssumeRole) - 1,
+				"tf_aws.assume_role.role_arn":        assumeRole.RoleARN,
+				"tf_aws.assume_role.session_name":    assumeRole.SessionName,
+				"tf_aws.assume_role.external_id":     assumeRole.ExternalID,
+				"tf_aws.assume_role.source_identity": assumeRole.SourceIdentity,
+			})
+		}
 	}
 
 	if v, ok := d.GetOk("assume_role_with_web_identity"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -534,6 +595,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if v, ok := d.GetOkExists("default_tags_propagation"); ok {
+			config.DefaultTagsConfig.PropagateAtLaunch = v.(bool)
+		}
 	}
 
 	v := d.Get("endpoints")
@@ -571,6 +636,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		diags = append(diags, expandRetry(ctx, v.([]interface{})[0].(map[string]interface{}), &config)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -607,7 +679,6 @@ func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
@@ -843,7 +914,9 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		return nil
 	}
 
-	defaultConfig := &tftags.DefaultConfig{}
+	defaultConfig := &tftags.DefaultConfig{
+		PropagateAtLaunch: true,
+	}
 
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
@@ -870,6 +943,64 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRetry(_ context.Context, tfMap map[string]interface{}, config *conns.Config) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v, ok := tfMap["max_attempts"].(int); ok && v != 0 {
+		config.MaxRetries = v
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		mode, err := aws.ParseRetryMode(v)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		config.RetryMode = mode
+	}
+
+	if v, ok := tfMap["service"].(*schema.Set); ok && v.Len() > 0 {
+		config.ServiceRetryConfigs = make(map[string]conns.ServiceRetryConfig)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			name := tfMap["name"].(string)
+			pkg := name
+			if !slices.Contains(names.ProviderPackages(), pkg) {
+				var err error
+				if pkg, err = names.ProviderPackageForAlias(name); err != nil {
+					return sdkdiag.AppendErrorf(diags, "retry service (%s): unsupported service", name)
+				}
+			}
+
+			if _, ok := config.ServiceRetryConfigs[pkg]; ok {
+				return sdkdiag.AppendErrorf(diags, "retry service (%s): duplicate configuration for service %s", name, pkg)
+			}
+
+			var serviceRetryConfig conns.ServiceRetryConfig
+
+			if v, ok := tfMap["max_attempts"].(int); ok && v != 0 {
+				serviceRetryConfig.MaxAttempts = v
+			}
+
+			if v, ok := tfMap["mode"].(string); ok && v != "" {
+				mode, err := aws.ParseRetryMode(v)
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "retry service (%s): %s", name, err)
+				}
+				serviceRetryConfig.Mode = mode
+			}
+
+			config.ServiceRetryConfigs[pkg] = serviceRetryConfig
+		}
+	}
+
+	return diags
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
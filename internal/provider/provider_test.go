@@ -9,9 +9,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -217,6 +220,106 @@ func TestEndpointEnvVarPrecedence(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serviceResource := p.Schema["retry"].Elem.(*schema.Resource).Schema["service"].Elem.(*schema.Resource)
+	newServices := func(tfList ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashResource(serviceResource), tfList)
+	}
+
+	testCases := map[string]struct {
+		tfMap     map[string]interface{}
+		want      conns.Config
+		expectErr bool
+	}{
+		"empty": {
+			tfMap: map[string]interface{}{
+				"service": newServices(),
+			},
+		},
+		"global": {
+			tfMap: map[string]interface{}{
+				"max_attempts": 10,
+				"mode":         "adaptive",
+				"service":      newServices(),
+			},
+			want: conns.Config{
+				MaxRetries: 10,
+				RetryMode:  aws.RetryModeAdaptive,
+			},
+		},
+		"services": {
+			tfMap: map[string]interface{}{
+				"service": newServices(
+					map[string]interface{}{"max_attempts": 50, "mode": "adaptive", "name": "ec2"},
+					map[string]interface{}{"max_attempts": 0, "mode": "", "name": "eventbridge"},
+				),
+			},
+			want: conns.Config{
+				ServiceRetryConfigs: map[string]conns.ServiceRetryConfig{
+					names.EC2:    {MaxAttempts: 50, Mode: aws.RetryModeAdaptive},
+					names.Events: {},
+				},
+			},
+		},
+		"duplicate service": {
+			tfMap: map[string]interface{}{
+				"service": newServices(
+					map[string]interface{}{"max_attempts": 50, "mode": "", "name": "events"},
+					map[string]interface{}{"max_attempts": 10, "mode": "", "name": "eventbridge"},
+				),
+			},
+			expectErr: true,
+		},
+		"unsupported service": {
+			tfMap: map[string]interface{}{
+				"service": newServices(
+					map[string]interface{}{"max_attempts": 50, "mode": "", "name": "not-a-service"},
+				),
+			},
+			expectErr: true,
+		},
+		"invalid mode": {
+			tfMap: map[string]interface{}{
+				"mode":    "fast",
+				"service": newServices(),
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got conns.Config
+			diags := expandRetry(ctx, testCase.tfMap, &got)
+
+			if got, want := diags.HasError(), testCase.expectErr; got != want {
+				t.Fatalf("expandRetry() error = %t, want %t: %v", got, want, diags)
+			}
+
+			if testCase.expectErr {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func stashEnv() []string {
 	env := os.Environ()
 	os.Clearenv()
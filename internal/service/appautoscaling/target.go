@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -65,6 +66,37 @@ func resourceTarget() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"scheduled_scaling": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrSchedule: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+					},
+				},
+			},
 			"service_namespace": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -104,6 +136,12 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(resourceID)
 
+	if v, ok := d.GetOk("scheduled_scaling"); ok && v.(*schema.Set).Len() > 0 {
+		if err := putTargetScheduledScalings(ctx, conn, d, v.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Application AutoScaling Target (%s): %s", resourceID, err)
+		}
+	}
+
 	return append(diags, resourceTargetRead(ctx, d, meta)...)
 }
 
@@ -138,6 +176,18 @@ func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("scalable_dimension", t.ScalableDimension)
 	d.Set("service_namespace", t.ServiceNamespace)
 
+	if v, ok := d.GetOk("scheduled_scaling"); ok && v.(*schema.Set).Len() > 0 {
+		scheduledScalings, err := findTargetScheduledScalings(ctx, conn, d, v.(*schema.Set).List())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Application AutoScaling Target (%s) scheduled actions: %s", d.Id(), err)
+		}
+
+		if err := d.Set("scheduled_scaling", scheduledScalings); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting scheduled_scaling: %s", err)
+		}
+	}
+
 	return diags
 }
 
@@ -145,7 +195,7 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AppAutoScalingConn(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "scheduled_scaling") {
 		input := &applicationautoscaling.RegisterScalableTargetInput{
 			MaxCapacity:       aws.Int64(int64(d.Get("max_capacity").(int))),
 			MinCapacity:       aws.Int64(int64(d.Get("min_capacity").(int))),
@@ -165,6 +215,19 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange("scheduled_scaling") {
+		o, n := d.GetChange("scheduled_scaling")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		if err := deleteTargetScheduledScalings(ctx, conn, d, os.List(), ns.List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Application AutoScaling Target (%s): %s", d.Id(), err)
+		}
+
+		if err := putTargetScheduledScalings(ctx, conn, d, ns.Difference(os).List()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Application AutoScaling Target (%s): %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceTargetRead(ctx, d, meta)...)
 }
 
@@ -288,3 +351,123 @@ func registerScalableTarget(ctx context.Context, conn *applicationautoscaling.Ap
 
 	return err
 }
+
+// putTargetScheduledScalings creates or updates the scheduled actions for the target's scheduled_scaling blocks.
+func putTargetScheduledScalings(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, d *schema.ResourceData, tfList []interface{}) error {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		input := &applicationautoscaling.PutScheduledActionInput{
+			ResourceId:        aws.String(d.Id()),
+			ScalableDimension: aws.String(d.Get("scalable_dimension").(string)),
+			ScalableTargetAction: &applicationautoscaling.ScalableTargetAction{
+				MaxCapacity: aws.Int64(int64(tfMap["max_capacity"].(int))),
+				MinCapacity: aws.Int64(int64(tfMap["min_capacity"].(int))),
+			},
+			Schedule:            aws.String(tfMap[names.AttrSchedule].(string)),
+			ScheduledActionName: aws.String(name),
+			ServiceNamespace:    aws.String(d.Get("service_namespace").(string)),
+			Timezone:            aws.String(tfMap["timezone"].(string)),
+		}
+
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.PutScheduledActionWithContext(ctx, input)
+		}, applicationautoscaling.ErrCodeObjectNotFoundException)
+
+		if err != nil {
+			return fmt.Errorf("putting scheduled action (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteTargetScheduledScalings deletes the scheduled actions whose scheduled_scaling blocks have been removed.
+func deleteTargetScheduledScalings(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, d *schema.ResourceData, old, new []interface{}) error {
+	keep := make(map[string]bool)
+	for _, tfMapRaw := range new {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			keep[tfMap[names.AttrName].(string)] = true
+		}
+	}
+
+	for _, tfMapRaw := range old {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if keep[name] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting Application AutoScaling Target (%s) scheduled action: %s", d.Id(), name)
+		_, err := conn.DeleteScheduledActionWithContext(ctx, &applicationautoscaling.DeleteScheduledActionInput{
+			ResourceId:          aws.String(d.Id()),
+			ScalableDimension:   aws.String(d.Get("scalable_dimension").(string)),
+			ScheduledActionName: aws.String(name),
+			ServiceNamespace:    aws.String(d.Get("service_namespace").(string)),
+		})
+
+		if tfawserr.ErrCodeEquals(err, applicationautoscaling.ErrCodeObjectNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting scheduled action (%s): %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// findTargetScheduledScalings returns the scheduled_scaling blocks for the target's scheduled actions.
+// Only scheduled actions named in the configuration are returned, so that scheduled actions managed
+// elsewhere, e.g. by aws_appautoscaling_scheduled_action, are ignored.
+func findTargetScheduledScalings(ctx context.Context, conn *applicationautoscaling.ApplicationAutoScaling, d *schema.ResourceData, tfList []interface{}) ([]interface{}, error) {
+	var scheduledActionNames []string
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			scheduledActionNames = append(scheduledActionNames, tfMap[names.AttrName].(string))
+		}
+	}
+
+	scalableDimension := d.Get("scalable_dimension").(string)
+	input := &applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:           aws.String(d.Id()),
+		ScalableDimension:    aws.String(scalableDimension),
+		ScheduledActionNames: aws.StringSlice(scheduledActionNames),
+		ServiceNamespace:     aws.String(d.Get("service_namespace").(string)),
+	}
+
+	output, err := findScheduledActions(ctx, conn, input, func(v *applicationautoscaling.ScheduledAction) bool {
+		return aws.StringValue(v.ScalableDimension) == scalableDimension
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var scheduledScalings []interface{}
+	for _, v := range output {
+		tfMap := map[string]interface{}{
+			names.AttrName:     aws.StringValue(v.ScheduledActionName),
+			names.AttrSchedule: aws.StringValue(v.Schedule),
+			"timezone":         aws.StringValue(v.Timezone),
+		}
+
+		if v := v.ScalableTargetAction; v != nil {
+			tfMap["max_capacity"] = aws.Int64Value(v.MaxCapacity)
+			tfMap["min_capacity"] = aws.Int64Value(v.MinCapacity)
+		}
+
+		scheduledScalings = append(scheduledScalings, tfMap)
+	}
+
+	return scheduledScalings, nil
+}
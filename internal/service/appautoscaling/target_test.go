@@ -255,6 +255,62 @@ func TestAccAppAutoScalingTarget_optionalRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingTarget_scheduledScaling(t *testing.T) {
+	ctx := acctest.Context(t)
+	var target applicationautoscaling.ScalableTarget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_scheduledScaling(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_scaling.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_scaling.*", map[string]string{
+						"max_capacity":     "1",
+						"min_capacity":     "1",
+						names.AttrName:     rName + "-scale-in",
+						names.AttrSchedule: "cron(0 20 * * ? *)",
+						"timezone":         "UTC",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_scaling.*", map[string]string{
+						"max_capacity":     "3",
+						"min_capacity":     "2",
+						names.AttrName:     rName + "-scale-out",
+						names.AttrSchedule: "cron(0 8 * * ? *)",
+						"timezone":         "Europe/London",
+					}),
+				),
+			},
+			{
+				Config: testAccTargetConfig_scheduledScaling(rName, 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_scaling.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_scaling.*", map[string]string{
+						"max_capacity": "3",
+						"min_capacity": "1",
+						names.AttrName: rName + "-scale-out",
+					}),
+				),
+			},
+			{
+				Config: testAccTargetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_scaling.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTargetDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingConn(ctx)
@@ -364,6 +420,49 @@ resource "aws_appautoscaling_target" "test" {
 `)
 }
 
+func testAccTargetConfig_scheduledScaling(rName string, scaleOutMinCapacity, count int) string {
+	return acctest.ConfigCompose(testAccTargetConfig_baseECS(rName, 1), fmt.Sprintf(`
+locals {
+  scheduled_scaling = [
+    {
+      name         = "%[1]s-scale-out"
+      schedule     = "cron(0 8 * * ? *)"
+      timezone     = "Europe/London"
+      min_capacity = %[2]d
+      max_capacity = 3
+    },
+    {
+      name         = "%[1]s-scale-in"
+      schedule     = "cron(0 20 * * ? *)"
+      timezone     = null
+      min_capacity = 1
+      max_capacity = 1
+    },
+  ]
+}
+
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "ecs"
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  min_capacity       = 1
+  max_capacity       = 3
+
+  dynamic "scheduled_scaling" {
+    for_each = slice(local.scheduled_scaling, 0, %[3]d)
+
+    content {
+      name         = scheduled_scaling.value.name
+      schedule     = scheduled_scaling.value.schedule
+      timezone     = scheduled_scaling.value.timezone
+      min_capacity = scheduled_scaling.value.min_capacity
+      max_capacity = scheduled_scaling.value.max_capacity
+    }
+  }
+}
+`, rName, scaleOutMinCapacity, count))
+}
+
 func testAccTargetConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_dynamodb_table" "test" {
@@ -35,6 +35,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -95,6 +96,26 @@ func resourceGroup() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			"default_tag": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"propagate_at_launch": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"desired_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -946,6 +967,7 @@ func resourceGroup() *schema.Resource {
 			launchTemplateCustomDiff("launch_template", "launch_template.0.name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.launch_template_specification.0.launch_template_name"),
 			launchTemplateCustomDiff("mixed_instances_policy", "mixed_instances_policy.0.launch_template.0.override"),
+			defaultTagCustomDiff,
 		),
 	}
 }
@@ -964,6 +986,53 @@ func instanceMaintenancePolicyDiffSupress(k, old, new string, d *schema.Resource
 	return false
 }
 
+// defaultTagCustomDiff plans the provider default tags applied to the Auto Scaling Group,
+// i.e. those whose keys aren't configured in a tag block.
+func defaultTagCustomDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tag") {
+		return diff.SetNewComputed("default_tag")
+	}
+
+	o := diff.Get("default_tag").(*schema.Set)
+	n := schema.NewSet(o.F, groupDefaultTags(meta, diff.Get("tag").(*schema.Set).List()))
+
+	if !n.Equal(o) {
+		return diff.SetNew("default_tag", n.List())
+	}
+
+	return nil
+}
+
+// groupDefaultTags returns the provider default tags whose keys aren't in the specified tag blocks.
+func groupDefaultTags(meta interface{}, tfList []interface{}) []interface{} {
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	if defaultTagsConfig == nil || len(defaultTagsConfig.Tags) == 0 {
+		return nil
+	}
+
+	configuredKeys := make(map[string]bool, len(tfList))
+	for _, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			configuredKeys[tfMap[names.AttrKey].(string)] = true
+		}
+	}
+
+	var tfDefaultList []interface{}
+	for k, v := range defaultTagsConfig.Tags.IgnoreConfig(meta.(*conns.AWSClient).IgnoreTagsConfig).Map() {
+		if configuredKeys[k] {
+			continue
+		}
+
+		tfDefaultList = append(tfDefaultList, map[string]interface{}{
+			names.AttrKey:         k,
+			"propagate_at_launch": defaultTagsConfig.PropagateAtLaunch,
+			names.AttrValue:       v,
+		})
+	}
+
+	return tfDefaultList
+}
+
 func launchTemplateCustomDiff(baseAttribute, subAttribute string) schema.CustomizeDiffFunc {
 	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
 		if diff.HasChange(subAttribute) {
@@ -1123,8 +1192,8 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		inputCASG.ServiceLinkedRoleARN = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tag"); ok {
-		inputCASG.Tags = Tags(KeyValueTags(ctx, v, asgName, TagResourceTypeGroup).IgnoreAWS())
+	if tfList := append(d.Get("tag").(*schema.Set).List(), d.Get("default_tag").(*schema.Set).List()...); len(tfList) > 0 {
+		inputCASG.Tags = Tags(KeyValueTags(ctx, tfList, asgName, TagResourceTypeGroup).IgnoreAWS())
 	}
 
 	if v, ok := d.GetOk("target_group_arns"); ok && len(v.(*schema.Set).List()) > 0 {
@@ -1337,7 +1406,17 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 	d.Set("warm_pool_size", g.WarmPoolSize)
 
-	if err := d.Set("tag", listOfMap(KeyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))); err != nil {
+	tags := KeyValueTags(ctx, g.Tags, d.Id(), TagResourceTypeGroup).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	var defaultTags tftags.KeyValueTags
+	if defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig; defaultTagsConfig != nil {
+		// Tags with default tag keys that aren't configured in tag blocks are provider default tags.
+		defaultTags = tags.Only(defaultTagsConfig.Tags.Ignore(KeyValueTags(ctx, d.Get("tag"), d.Id(), TagResourceTypeGroup)))
+	}
+
+	if err := d.Set("default_tag", listOfMap(defaultTags)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting default_tag: %s", err)
+	}
+	if err := d.Set("tag", listOfMap(tags.Ignore(defaultTags))); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tag: %s", err)
 	}
 
@@ -1481,10 +1560,11 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
-	if d.HasChanges("tag") {
+	if d.HasChanges("default_tag", "tag") {
 		oTagRaw, nTagRaw := d.GetChange("tag")
-		oldTags := Tags(KeyValueTags(ctx, oTagRaw, d.Id(), TagResourceTypeGroup))
-		newTags := Tags(KeyValueTags(ctx, nTagRaw, d.Id(), TagResourceTypeGroup))
+		oDefaultTagRaw, nDefaultTagRaw := d.GetChange("default_tag")
+		oldTags := Tags(KeyValueTags(ctx, append(oTagRaw.(*schema.Set).List(), oDefaultTagRaw.(*schema.Set).List()...), d.Id(), TagResourceTypeGroup))
+		newTags := Tags(KeyValueTags(ctx, append(nTagRaw.(*schema.Set).List(), nDefaultTagRaw.(*schema.Set).List()...), d.Id(), TagResourceTypeGroup))
 
 		if err := updateTags(ctx, conn, d.Id(), TagResourceTypeGroup, oldTags, newTags); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating tags for Auto Scaling Group (%s): %s", d.Id(), err)
@@ -284,6 +284,85 @@ func TestAccAutoScalingGroup_tags(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_DefaultTags_providerOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccGroupConfig_tags1(rName, "key1", "value1", false),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "default_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_tag.*", map[string]string{
+						names.AttrKey:         "providerkey1",
+						names.AttrValue:       "providervalue1",
+						"propagate_at_launch": "true",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags2("providerkey1", "providervalue1", "key1", "providervalue2"),
+					testAccGroupConfig_tags1(rName, "key1", "value1", false),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "default_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_tag.*", map[string]string{
+						names.AttrKey:   "providerkey1",
+						names.AttrValue: "providervalue1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "tag.*", map[string]string{
+						names.AttrKey:         "key1",
+						names.AttrValue:       "value1",
+						"propagate_at_launch": "false",
+					}),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					testAccGroupConfig_defaultTagsPropagation("providerkey1", "providervalue1updated", false),
+					testAccGroupConfig_tags1(rName, "key1", "value1", false),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "default_tag.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "default_tag.*", map[string]string{
+						names.AttrKey:         "providerkey1",
+						names.AttrValue:       "providervalue1updated",
+						"propagate_at_launch": "false",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+				),
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags0(),
+					testAccGroupConfig_tags1(rName, "key1", "value1", false),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "default_tag.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tag.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_simple(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group awstypes.AutoScalingGroup
@@ -4272,6 +4351,25 @@ resource "aws_autoscaling_group" "test" {
 `, namePrefix))
 }
 
+func testAccGroupConfig_defaultTagsPropagation(tagKey1, tagValue1 string, propagation bool) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+  }
+
+  default_tags_propagation = %[3]t
+
+  skip_credentials_validation = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, tagKey1, tagValue1, propagation)
+}
+
 func testAccGroupConfig_tags1(rName, tagKey1, tagValue1 string, tagPropagateAtLaunch1 bool) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchConfigurationBase(rName, "t2.micro"), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
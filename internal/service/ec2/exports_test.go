@@ -30,27 +30,31 @@ var (
 	ResourceVPNGatewayAttachment            = resourceVPNGatewayAttachment
 	ResourceVPNGatewayRoutePropagation      = resourceVPNGatewayRoutePropagation
 
-	CustomFiltersSchema                       = customFiltersSchema
-	FindEBSVolumeAttachment                   = findVolumeAttachment
-	FindEIPByAllocationID                     = findEIPByAllocationID
-	FindEIPByAssociationID                    = findEIPByAssociationID
-	FindEIPDomainNameAttributeByAllocationID  = findEIPDomainNameAttributeByAllocationID
-	FindFastSnapshotRestoreByTwoPartKey       = findFastSnapshotRestoreByTwoPartKey
-	FindInstanceMetadataDefaults              = findInstanceMetadataDefaults
-	FindKeyPairByName                         = findKeyPairByName
-	FindNetworkACLByIDV2                      = findNetworkACLByIDV2
-	FindNetworkInterfaceByIDV2                = findNetworkInterfaceByIDV2
-	FindVolumeAttachmentInstanceByID          = findVolumeAttachmentInstanceByID
-	FlattenNetworkInterfacePrivateIPAddresses = flattenNetworkInterfacePrivateIPAddresses
-	NewAttributeFilterList                    = newAttributeFilterList
-	NewAttributeFilterListV2                  = newAttributeFilterListV2
-	NewCustomFilterList                       = newCustomFilterList
-	NewTagFilterList                          = newTagFilterList
-	ProtocolForValue                          = protocolForValue
-	StopInstance                              = stopInstance
-	StopEBSVolumeAttachmentInstance           = stopVolumeAttachmentInstance
-	UpdateTags                                = updateTags
-	UpdateTagsV2                              = updateTagsV2
+	CustomFiltersSchema                           = customFiltersSchema
+	FindEBSVolumeAttachment                       = findVolumeAttachment
+	FindEIPByAllocationID                         = findEIPByAllocationID
+	FindEIPByAssociationID                        = findEIPByAssociationID
+	FindEIPDomainNameAttributeByAllocationID      = findEIPDomainNameAttributeByAllocationID
+	FindFastSnapshotRestoreByTwoPartKey           = findFastSnapshotRestoreByTwoPartKey
+	FindInstanceMetadataDefaults                  = findInstanceMetadataDefaults
+	FindKeyPairByName                             = findKeyPairByName
+	FindNetworkACLByIDV2                          = findNetworkACLByIDV2
+	FindNetworkInterfaceByIDV2                    = findNetworkInterfaceByIDV2
+	FindTransitGatewayResourceShareByARN          = findTransitGatewayResourceShareByARN
+	FindVolumeAttachmentInstanceByID              = findVolumeAttachmentInstanceByID
+	FlattenNetworkInterfacePrivateIPAddresses     = flattenNetworkInterfacePrivateIPAddresses
+	NewAttributeFilterList                        = newAttributeFilterList
+	NewAttributeFilterListV2                      = newAttributeFilterListV2
+	NewCustomFilterList                           = newCustomFilterList
+	NewSecurityGroupRulesDocument                 = newSecurityGroupRulesDocument
+	NewTagFilterList                              = newTagFilterList
+	ProtocolForValue                              = protocolForValue
+	StopInstance                                  = stopInstance
+	StopEBSVolumeAttachmentInstance               = stopVolumeAttachmentInstance
+	TransitGatewayResourceShareAssociationsStatus = transitGatewayResourceShareAssociationsStatus
+	UpdateTags                                    = updateTags
+	UpdateTagsV2                                  = updateTagsV2
+	VPCDependencies                               = vpcDependencies
 )
 
 type (
@@ -4389,6 +4389,41 @@ func FindTransitGatewayStaticRoute(ctx context.Context, conn *ec2.EC2, transitGa
 	return nil, &retry.NotFoundError{}
 }
 
+// findTransitGatewayRouteByDestination returns the route of any type, e.g. static or propagated,
+// for the specified destination in a transit gateway route table.
+func findTransitGatewayRouteByDestination(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, destination string) (*ec2.TransitGatewayRoute, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"route-search.exact-match": destination,
+		}),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	output, err := FindTransitGatewayRoutes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, route := range output {
+		if route == nil {
+			continue
+		}
+
+		if v := aws.StringValue(route.DestinationCidrBlock); types.CIDRBlocksEqual(v, destination) {
+			if state := aws.StringValue(route.State); state == ec2.TransitGatewayRouteStateDeleted {
+				continue
+			}
+
+			return route, nil
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func FindTransitGatewayRoutes(ctx context.Context, conn *ec2.EC2, input *ec2.SearchTransitGatewayRoutesInput) ([]*ec2.TransitGatewayRoute, error) {
 	output, err := conn.SearchTransitGatewayRoutesWithContext(ctx, input)
 
@@ -4757,6 +4792,37 @@ func FindTransitGatewayRouteTablePropagations(ctx context.Context, conn *ec2.EC2
 	return output, nil
 }
 
+func FindTransitGatewayAttachmentPropagations(ctx context.Context, conn *ec2.EC2, input *ec2.GetTransitGatewayAttachmentPropagationsInput) ([]*ec2.TransitGatewayAttachmentPropagation, error) {
+	var output []*ec2.TransitGatewayAttachmentPropagation
+
+	err := conn.GetTransitGatewayAttachmentPropagationsPagesWithContext(ctx, input, func(page *ec2.GetTransitGatewayAttachmentPropagationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayAttachmentPropagations {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindTransitGatewayVPCAttachment(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeTransitGatewayVpcAttachmentsInput) (*ec2.TransitGatewayVpcAttachment, error) {
 	output, err := FindTransitGatewayVPCAttachments(ctx, conn, input)
 
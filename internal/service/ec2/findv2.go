@@ -248,6 +248,30 @@ func findRouteTablesV2(ctx context.Context, conn *ec2.Client, input *ec2.Describ
 	return output, nil
 }
 
+func findSubnetsV2(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSubnetsInput) ([]awstypes.Subnet, error) {
+	var output []awstypes.Subnet
+
+	pages := ec2.NewDescribeSubnetsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidSubnetIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Subnets...)
+	}
+
+	return output, nil
+}
+
 func findSecurityGroupV2(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSecurityGroupsInput) (*awstypes.SecurityGroup, error) {
 	output, err := findSecurityGroupsV2(ctx, conn, input)
 
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/netip"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_vpc_ipam_pool_utilization", name="IPAM Pool Utilization")
+func dataSourceIPAMPoolUtilization() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIPAMPoolUtilizationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"address_family": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"allocated_address_count": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ipam_pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"planned_allocation_netmask_lengths": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(0, 128),
+				},
+			},
+			"projected_utilization": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"threshold_exceeded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"total_address_count": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"utilization": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"utilization_threshold": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatBetween(0, 100),
+			},
+		},
+	}
+}
+
+func dataSourceIPAMPoolUtilizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	poolID := d.Get("ipam_pool_id").(string)
+	pool, err := FindIPAMPoolByID(ctx, conn, poolID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Pool (%s): %s", poolID, err)
+	}
+
+	addressBits := 32
+	if aws.StringValue(pool.AddressFamily) == ec2.AddressFamilyIpv6 {
+		addressBits = 128
+	}
+
+	cidrs, err := FindIPAMPoolCIDRs(ctx, conn, &ec2.GetIpamPoolCidrsInput{
+		IpamPoolId: aws.String(poolID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Pool (%s) CIDRs: %s", poolID, err)
+	}
+
+	total := new(big.Int)
+	for _, v := range cidrs {
+		// Only provisioned CIDRs are available for allocation.
+		if aws.StringValue(v.State) != ec2.IpamPoolCidrStateProvisioned {
+			continue
+		}
+
+		n, err := cidrAddressCount(aws.StringValue(v.Cidr))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IPAM Pool (%s) CIDRs: %s", poolID, err)
+		}
+
+		total.Add(total, n)
+	}
+
+	allocations, err := FindIPAMPoolAllocations(ctx, conn, &ec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Pool (%s) allocations: %s", poolID, err)
+	}
+
+	allocated := new(big.Int)
+	for _, v := range allocations {
+		n, err := cidrAddressCount(aws.StringValue(v.Cidr))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IPAM Pool (%s) allocations: %s", poolID, err)
+		}
+
+		allocated.Add(allocated, n)
+	}
+
+	projected := new(big.Int).Set(allocated)
+	for _, v := range d.Get("planned_allocation_netmask_lengths").([]interface{}) {
+		netmaskLength := v.(int)
+
+		if netmaskLength > addressBits {
+			return sdkdiag.AppendErrorf(diags, "planned allocation netmask length (%d) is longer than the IPAM Pool (%s) address length (%d)", netmaskLength, poolID, addressBits)
+		}
+
+		projected.Add(projected, new(big.Int).Lsh(big.NewInt(1), uint(addressBits-netmaskLength)))
+	}
+
+	utilization, projectedUtilization := percentOf(allocated, total), percentOf(projected, total)
+
+	thresholdExceeded := false
+	if v, ok := d.GetOk("utilization_threshold"); ok {
+		if threshold := v.(float64); projectedUtilization > threshold {
+			thresholdExceeded = true
+			diags = sdkdiag.AppendWarningf(diags, "IPAM Pool (%s) projected utilization (%.2f%%) exceeds threshold (%.2f%%)", poolID, projectedUtilization, threshold)
+		}
+	}
+
+	d.SetId(poolID)
+	d.Set("address_family", pool.AddressFamily)
+	d.Set("allocated_address_count", allocated.String())
+	d.Set("projected_utilization", projectedUtilization)
+	d.Set("threshold_exceeded", thresholdExceeded)
+	d.Set("total_address_count", total.String())
+	d.Set("utilization", utilization)
+
+	return diags
+}
+
+// cidrAddressCount returns the number of addresses in the specified CIDR block.
+func cidrAddressCount(cidr string) (*big.Int, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing CIDR block (%s): %w", cidr, err)
+	}
+
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits())), nil
+}
+
+// percentOf returns n as a percentage of total, or 0 if total is 0.
+func percentOf(n, total *big.Int) float64 {
+	if total.Sign() == 0 {
+		return 0
+	}
+
+	v, _ := new(big.Float).Quo(new(big.Float).SetInt(new(big.Int).Mul(n, big.NewInt(100))), new(big.Float).SetInt(total)).Float64()
+
+	return v
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIPAMPoolUtilizationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_ipam_pool_utilization.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMPoolUtilizationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "address_family", "ipv4"),
+					resource.TestCheckResourceAttr(dataSourceName, "allocated_address_count", "16384"),
+					resource.TestCheckResourceAttr(dataSourceName, "projected_utilization", "25"),
+					resource.TestCheckResourceAttr(dataSourceName, "threshold_exceeded", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "total_address_count", "65536"),
+					resource.TestCheckResourceAttr(dataSourceName, "utilization", "25"),
+				),
+			},
+			{
+				Config: testAccIPAMPoolUtilizationDataSourceConfig_planned,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "projected_utilization", "50"),
+					resource.TestCheckResourceAttr(dataSourceName, "threshold_exceeded", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "utilization", "25"),
+				),
+			},
+		},
+	})
+}
+
+var testAccIPAMPoolUtilizationDataSourceConfig_base = acctest.ConfigCompose(testAccIPAMPoolConfig_basic, `
+resource "aws_vpc_ipam_pool_cidr" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+  cidr         = "172.2.0.0/16"
+}
+
+resource "aws_vpc_ipam_pool_cidr_allocation" "test" {
+  ipam_pool_id   = aws_vpc_ipam_pool.test.id
+  netmask_length = 18
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr.test
+  ]
+}
+`)
+
+var testAccIPAMPoolUtilizationDataSourceConfig_basic = acctest.ConfigCompose(testAccIPAMPoolUtilizationDataSourceConfig_base, `
+data "aws_vpc_ipam_pool_utilization" "test" {
+  ipam_pool_id = aws_vpc_ipam_pool.test.id
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr_allocation.test
+  ]
+}
+`)
+
+var testAccIPAMPoolUtilizationDataSourceConfig_planned = acctest.ConfigCompose(testAccIPAMPoolUtilizationDataSourceConfig_base, `
+data "aws_vpc_ipam_pool_utilization" "test" {
+  ipam_pool_id                       = aws_vpc_ipam_pool.test.id
+  planned_allocation_netmask_lengths = [18]
+  utilization_threshold              = 40
+
+  depends_on = [
+    aws_vpc_ipam_pool_cidr_allocation.test
+  ]
+}
+`)
@@ -5,11 +5,13 @@ package ec2
 
 import (
 	"context"
+	"time"
 
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
 	ec2_sdkv1 "github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // CustomizeConn customizes a new AWS SDK for Go v1 client for this service package's AWS API.
@@ -51,3 +53,17 @@ func (p *servicePackage) CustomizeConn(ctx context.Context, conn *ec2_sdkv1.EC2)
 
 	return conn, nil
 }
+
+// AsyncWaiters returns the waiters for resources of this service package created with `async_create = true`, keyed by resource type.
+func (p *servicePackage) AsyncWaiters(context.Context) map[string]conns.AsyncWaiter {
+	return map[string]conns.AsyncWaiter{
+		"aws_ec2_transit_gateway_peering_attachment": func(ctx context.Context, client *conns.AWSClient, id string, timeout time.Duration) error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			_, err := WaitTransitGatewayPeeringAttachmentCreated(ctx, client.EC2Conn(ctx), id)
+
+			return err
+		},
+	}
+}
@@ -223,6 +223,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTransitGatewayConnect,
 			TypeName: "aws_ec2_transit_gateway_connect",
 		},
+		{
+			Factory:  DataSourceTransitGatewayConnectBGPStatus,
+			TypeName: "aws_ec2_transit_gateway_connect_bgp_status",
+		},
 		{
 			Factory:  DataSourceTransitGatewayConnectPeer,
 			TypeName: "aws_ec2_transit_gateway_connect_peer",
@@ -273,6 +277,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceTransitGatewayVPNAttachment,
 			TypeName: "aws_ec2_transit_gateway_vpn_attachment",
 		},
+		{
+			Factory:  dataSourceTransitGateways,
+			TypeName: "aws_ec2_transit_gateways",
+			Name:     "Transit Gateways",
+		},
 		{
 			Factory:  dataSourceEIP,
 			TypeName: "aws_eip",
@@ -348,6 +357,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceSecurityGroup,
 			TypeName: "aws_security_group",
 		},
+		{
+			Factory:  DataSourceSecurityGroupRulesExporter,
+			TypeName: "aws_security_group_rules_exporter",
+		},
 		{
 			Factory:  DataSourceSecurityGroups,
 			TypeName: "aws_security_groups",
@@ -366,6 +379,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "VPC",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceVPCAvailabilityZoneCapacity,
+			TypeName: "aws_vpc_availability_zone_capacity",
+			Name:     "VPC Availability Zone Capacity",
+		},
 		{
 			Factory:  DataSourceVPCDHCPOptions,
 			TypeName: "aws_vpc_dhcp_options",
@@ -386,6 +404,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceIPAMPoolCIDRs,
 			TypeName: "aws_vpc_ipam_pool_cidrs",
 		},
+		{
+			Factory:  dataSourceIPAMPoolUtilization,
+			TypeName: "aws_vpc_ipam_pool_utilization",
+			Name:     "IPAM Pool Utilization",
+		},
 		{
 			Factory:  DataSourceIPAMPools,
 			TypeName: "aws_vpc_ipam_pools",
@@ -750,6 +773,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceTransitGatewayPeeringMesh,
+			TypeName: "aws_ec2_transit_gateway_peering_mesh",
+		},
 		{
 			Factory:  ResourceTransitGatewayPolicyTable,
 			TypeName: "aws_ec2_transit_gateway_policy_table",
@@ -766,6 +793,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTransitGatewayPrefixListReference,
 			TypeName: "aws_ec2_transit_gateway_prefix_list_reference",
 		},
+		{
+			Factory:  ResourceTransitGatewayResourceShare,
+			TypeName: "aws_ec2_transit_gateway_resource_share",
+		},
 		{
 			Factory:  ResourceTransitGatewayRoute,
 			TypeName: "aws_ec2_transit_gateway_route",
@@ -782,6 +813,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceTransitGatewayRouteTableAssociation,
 			TypeName: "aws_ec2_transit_gateway_route_table_association",
 		},
+		{
+			Factory:  ResourceTransitGatewayRouteTableBlackhole,
+			TypeName: "aws_ec2_transit_gateway_route_table_blackhole",
+		},
 		{
 			Factory:  ResourceTransitGatewayRouteTablePropagation,
 			TypeName: "aws_ec2_transit_gateway_route_table_propagation",
@@ -113,6 +113,12 @@ func ResourceTransitGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"security_group_referencing_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SecurityGroupReferencingSupportValue_Values(), false),
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"transit_gateway_cidr_blocks": {
@@ -156,6 +162,10 @@ func resourceTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, m
 		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeTransitGateway),
 	}
 
+	if v, ok := d.GetOk("security_group_referencing_support"); ok {
+		input.Options.SecurityGroupReferencingSupport = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("amazon_side_asn"); ok {
 		input.Options.AmazonSideAsn = aws.Int64(int64(v.(int)))
 	}
@@ -211,6 +221,7 @@ func resourceTransitGatewayRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set("multicast_support", transitGateway.Options.MulticastSupport)
 	d.Set(names.AttrOwnerID, transitGateway.OwnerId)
 	d.Set("propagation_default_route_table_id", transitGateway.Options.PropagationDefaultRouteTableId)
+	d.Set("security_group_referencing_support", transitGateway.Options.SecurityGroupReferencingSupport)
 	d.Set("transit_gateway_cidr_blocks", aws.StringValueSlice(transitGateway.Options.TransitGatewayCidrBlocks))
 	d.Set("vpn_ecmp_support", transitGateway.Options.VpnEcmpSupport)
 
@@ -253,6 +264,10 @@ func resourceTransitGatewayUpdate(ctx context.Context, d *schema.ResourceData, m
 			input.Options.DnsSupport = aws.String(d.Get("dns_support").(string))
 		}
 
+		if d.HasChange("security_group_referencing_support") {
+			input.Options.SecurityGroupReferencingSupport = aws.String(d.Get("security_group_referencing_support").(string))
+		}
+
 		if d.HasChange("transit_gateway_cidr_blocks") {
 			oRaw, nRaw := d.GetChange("transit_gateway_cidr_blocks")
 			o, n := oRaw.(*schema.Set), nRaw.(*schema.Set)
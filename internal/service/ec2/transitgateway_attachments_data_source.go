@@ -27,13 +27,43 @@ func DataSourceTransitGatewayAttachments() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"attachment_tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID:   {Type: schema.TypeString, Computed: true},
+						names.AttrTags: tftags.TagsSchemaComputed(),
+					},
+				},
+			},
+			"connect_attachment_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrFilter: customFiltersSchema(),
 			"ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"peering_attachment_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"vpc_attachment_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpn_attachment_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -41,6 +71,7 @@ func DataSourceTransitGatewayAttachments() *schema.Resource {
 func dataSourceTransitGatewayAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := &ec2.DescribeTransitGatewayAttachmentsInput{}
 
@@ -61,13 +92,42 @@ func dataSourceTransitGatewayAttachmentsRead(ctx context.Context, d *schema.Reso
 	}
 
 	var attachmentIDs []string
+	var attachmentTags []interface{}
+	// Attachment IDs mapped to the IDs of the attached resources, by resource type.
+	connectAttachmentIDs := make(map[string]string)
+	peeringAttachmentIDs := make(map[string]string)
+	vpcAttachmentIDs := make(map[string]string)
+	vpnAttachmentIDs := make(map[string]string)
 
 	for _, v := range transitGatewayAttachments {
-		attachmentIDs = append(attachmentIDs, aws.StringValue(v.TransitGatewayAttachmentId))
+		attachmentID, resourceID := aws.StringValue(v.TransitGatewayAttachmentId), aws.StringValue(v.ResourceId)
+		attachmentIDs = append(attachmentIDs, attachmentID)
+		attachmentTags = append(attachmentTags, map[string]interface{}{
+			names.AttrID:   attachmentID,
+			names.AttrTags: KeyValueTags(ctx, v.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+		})
+
+		switch aws.StringValue(v.ResourceType) {
+		case ec2.TransitGatewayAttachmentResourceTypeConnect:
+			connectAttachmentIDs[attachmentID] = resourceID
+		case ec2.TransitGatewayAttachmentResourceTypePeering:
+			peeringAttachmentIDs[attachmentID] = resourceID
+		case ec2.TransitGatewayAttachmentResourceTypeVpc:
+			vpcAttachmentIDs[attachmentID] = resourceID
+		case ec2.TransitGatewayAttachmentResourceTypeVpn:
+			vpnAttachmentIDs[attachmentID] = resourceID
+		}
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("attachment_tags", attachmentTags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachment_tags: %s", err)
+	}
+	d.Set("connect_attachment_ids", connectAttachmentIDs)
 	d.Set("ids", attachmentIDs)
+	d.Set("peering_attachment_ids", peeringAttachmentIDs)
+	d.Set("vpc_attachment_ids", vpcAttachmentIDs)
+	d.Set("vpn_attachment_ids", vpnAttachmentIDs)
 
 	return diags
 }
@@ -32,6 +32,14 @@ func testAccTransitGatewayAttachmentsDataSource_Filter(t *testing.T, semaphore t
 				Config: testAccTransitGatewayAttachmentsDataSourceConfig_filter(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachment_tags.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachment_tags.0.id", dataSourceName, "ids.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachment_tags.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachment_tags.0.tags.Name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "connect_attachment_ids.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "peering_attachment_ids.%", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpc_attachment_ids.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpn_attachment_ids.%", "0"),
 				),
 			},
 		},
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_transit_gateway_connect_bgp_status")
+func DataSourceTransitGatewayConnectBGPStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewayConnectBGPStatusRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bgp_received_prefix_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"bgp_received_prefix_count_truncated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"connect_peer": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_configuration": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bgp_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"peer_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"peer_asn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_gateway_address": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"transit_gateway_asn": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_connect_peer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"connect_peer_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			names.AttrTransitGatewayAttachmentID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayConnectBGPStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayAttachmentID := d.Get(names.AttrTransitGatewayAttachmentID).(string)
+	input := &ec2.DescribeTransitGatewayConnectPeersInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"transit-gateway-attachment-id": transitGatewayAttachmentID,
+		}),
+	}
+
+	output, err := FindTransitGatewayConnectPeers(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Connect (%s) peers: %s", transitGatewayAttachmentID, err)
+	}
+
+	var tfList []interface{}
+	var connectPeerCount int
+
+	for _, v := range output {
+		switch aws.StringValue(v.State) {
+		case ec2.TransitGatewayConnectPeerStateDeleted:
+			continue
+		case ec2.TransitGatewayConnectPeerStateDeleting:
+		default:
+			connectPeerCount++
+		}
+
+		tfList = append(tfList, flattenTransitGatewayConnectPeerBGPStatus(v))
+	}
+
+	transitGatewayAttachment, err := FindTransitGatewayAttachmentByID(ctx, conn, transitGatewayAttachmentID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Attachment (%s): %s", transitGatewayAttachmentID, err)
+	}
+
+	// We cannot read Transit Gateway Route Tables for Resource Access Manager shared Transit Gateways
+	var bgpReceivedPrefixCount int
+	var bgpReceivedPrefixCountTruncated bool
+
+	if aws.StringValue(transitGatewayAttachment.TransitGatewayOwnerId) == aws.StringValue(transitGatewayAttachment.ResourceOwnerId) {
+		bgpReceivedPrefixCount, bgpReceivedPrefixCountTruncated, err = transitGatewayConnectBGPPrefixCount(ctx, conn, transitGatewayAttachmentID)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	d.SetId(transitGatewayAttachmentID)
+	d.Set("bgp_received_prefix_count", bgpReceivedPrefixCount)
+	d.Set("bgp_received_prefix_count_truncated", bgpReceivedPrefixCountTruncated)
+	if err := d.Set("connect_peer", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting connect_peer: %s", err)
+	}
+	d.Set("connect_peer_count", connectPeerCount)
+
+	return diags
+}
+
+// transitGatewayConnectBGPPrefixCount returns the number of distinct prefixes that the BGP peers of the specified Transit Gateway Connect attachment
+// have advertised, i.e. the routes propagated from the attachment into the Transit Gateway Route Tables.
+// SearchTransitGatewayRoutes returns at most 1000 routes per route table, so the count is reported as truncated if any route table has more.
+func transitGatewayConnectBGPPrefixCount(ctx context.Context, conn *ec2.EC2, transitGatewayAttachmentID string) (int, bool, error) {
+	propagations, err := FindTransitGatewayAttachmentPropagations(ctx, conn, &ec2.GetTransitGatewayAttachmentPropagationsInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+	})
+
+	if err != nil {
+		return 0, false, fmt.Errorf("reading EC2 Transit Gateway Attachment (%s) propagations: %w", transitGatewayAttachmentID, err)
+	}
+
+	prefixes := make(map[string]struct{})
+	var truncated bool
+
+	for _, v := range propagations {
+		if aws.StringValue(v.State) != ec2.TransitGatewayPropagationStateEnabled {
+			continue
+		}
+
+		transitGatewayRouteTableID := aws.StringValue(v.TransitGatewayRouteTableId)
+		input := &ec2.SearchTransitGatewayRoutesInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"attachment.transit-gateway-attachment-id": transitGatewayAttachmentID,
+				"type": ec2.TransitGatewayRouteTypePropagated,
+			}),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		}
+
+		output, err := conn.SearchTransitGatewayRoutesWithContext(ctx, input)
+
+		if err != nil {
+			return 0, false, fmt.Errorf("searching EC2 Transit Gateway Route Table (%s) routes: %w", transitGatewayRouteTableID, err)
+		}
+
+		if output == nil {
+			continue
+		}
+
+		if aws.BoolValue(output.AdditionalRoutesAvailable) {
+			truncated = true
+		}
+
+		for _, v := range output.Routes {
+			if v := aws.StringValue(v.DestinationCidrBlock); v != "" {
+				prefixes[v] = struct{}{}
+			}
+		}
+	}
+
+	return len(prefixes), truncated, nil
+}
+
+func flattenTransitGatewayConnectPeerBGPStatus(apiObject *ec2.TransitGatewayConnectPeer) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrState:                   aws.StringValue(apiObject.State),
+		"transit_gateway_connect_peer_id": aws.StringValue(apiObject.TransitGatewayConnectPeerId),
+	}
+
+	if apiObject.ConnectPeerConfiguration == nil {
+		return tfMap
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.ConnectPeerConfiguration.BgpConfigurations {
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"bgp_status":              aws.StringValue(v.BgpStatus),
+			"peer_address":            aws.StringValue(v.PeerAddress),
+			"peer_asn":                strconv.FormatInt(aws.Int64Value(v.PeerAsn), 10),
+			"transit_gateway_address": aws.StringValue(v.TransitGatewayAddress),
+			"transit_gateway_asn":     strconv.FormatInt(aws.Int64Value(v.TransitGatewayAsn), 10),
+		})
+	}
+
+	tfMap["bgp_configuration"] = tfList
+
+	return tfMap
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayConnectBGPStatusDataSource_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_transit_gateway_connect_bgp_status.test"
+	resourceName := "aws_ec2_transit_gateway_connect_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGatewayConnect(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConnectBGPStatusDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "bgp_received_prefix_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "bgp_received_prefix_count_truncated", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "connect_peer.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "connect_peer.0.bgp_configuration.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "connect_peer.0.bgp_configuration.0.bgp_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connect_peer.0.bgp_configuration.0.peer_asn", resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttr(dataSourceName, "connect_peer.0.state", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "connect_peer.0.transit_gateway_connect_peer_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "connect_peer_count", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayAttachmentID, resourceName, names.AttrTransitGatewayAttachmentID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayConnectBGPStatusDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  transit_gateway_cidr_blocks = ["10.20.30.0/24"]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_connect" "test" {
+  transit_gateway_id      = aws_ec2_transit_gateway.test.id
+  transport_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_connect_peer" "test" {
+  inside_cidr_blocks            = ["169.254.200.0/29"]
+  peer_address                  = "1.1.1.1"
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateway_connect_bgp_status" "test" {
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_connect_peer.test.transit_gateway_attachment_id
+}
+`, rName))
+}
@@ -30,6 +30,9 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"Filter": testAccTransitGatewayConnectDataSource_Filter,
 			"ID":     testAccTransitGatewayConnectDataSource_ID,
 		},
+		"ConnectBGPStatus": {
+			"basic": testAccTransitGatewayConnectBGPStatusDataSource_basic,
+		},
 		"ConnectPeer": {
 			"Filter": testAccTransitGatewayConnectPeerDataSource_Filter,
 			"ID":     testAccTransitGatewayConnectPeerDataSource_ID,
@@ -42,6 +45,11 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"Filter": testAccTransitGatewayDataSource_Filter,
 			"ID":     testAccTransitGatewayDataSource_ID,
 		},
+		"Gateways": {
+			"Filter": testAccTransitGatewaysDataSource_filter,
+			"Tags":   testAccTransitGatewaysDataSource_tags,
+			"Empty":  testAccTransitGatewaysDataSource_empty,
+		},
 		"MulticastDomain": {
 			"Filter": testAccTransitGatewayMulticastDomainDataSource_Filter,
 			"ID":     testAccTransitGatewayMulticastDomainDataSource_ID,
@@ -52,10 +60,12 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"IDSameAccount":          testAccTransitGatewayPeeringAttachmentDataSource_ID_sameAccount,
 			"IDDifferentAccount":     testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount,
 			"Tags":                   testAccTransitGatewayPeeringAttachmentDataSource_Tags,
+			"PeerDifferentAccount":   testAccTransitGatewayPeeringAttachmentDataSource_Peer_differentAccount,
 		},
 		"RouteTable": {
-			"Filter": testAccTransitGatewayRouteTableDataSource_Filter,
-			"ID":     testAccTransitGatewayRouteTableDataSource_ID,
+			"Filter":                     testAccTransitGatewayRouteTableDataSource_Filter,
+			"ID":                         testAccTransitGatewayRouteTableDataSource_ID,
+			"TransitGatewayAttachmentID": testAccTransitGatewayRouteTableDataSource_transitGatewayAttachmentID,
 		},
 		"RouteTables": {
 			"basic":  testAccTransitGatewayRouteTablesDataSource_basic,
@@ -36,6 +36,14 @@ func resourceTransitGatewayPeeringAttachment() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Schema: map[string]*schema.Schema{
+			"async_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"cleanup_routes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"peer_account_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -93,8 +101,10 @@ func resourceTransitGatewayPeeringAttachmentCreate(ctx context.Context, d *schem
 
 	d.SetId(aws.StringValue(output.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId))
 
-	if _, err := WaitTransitGatewayPeeringAttachmentCreated(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Peering Attachment (%s) create: %s", d.Id(), err)
+	if !d.Get("async_create").(bool) {
+		if _, err := WaitTransitGatewayPeeringAttachmentCreated(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Peering Attachment (%s) create: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceTransitGatewayPeeringAttachmentRead(ctx, d, meta)...)
@@ -139,6 +149,12 @@ func resourceTransitGatewayPeeringAttachmentDelete(ctx context.Context, d *schem
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	if d.Get("cleanup_routes").(bool) {
+		if err := deleteTransitGatewayAttachmentStaticRoutes(ctx, conn, d.Get(names.AttrTransitGatewayID).(string), d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Peering Attachment: %s", d.Id())
 	_, err := conn.DeleteTransitGatewayPeeringAttachmentWithContext(ctx, &ec2.DeleteTransitGatewayPeeringAttachmentInput{
 		TransitGatewayAttachmentId: aws.String(d.Id()),
@@ -11,10 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -36,11 +39,14 @@ func dataSourceTransitGatewayPeeringAttachment() *schema.Resource {
 				Computed: true,
 			},
 			"peer_account_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
 			},
 			"peer_region": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"peer_transit_gateway_id": {
@@ -48,8 +54,10 @@ func dataSourceTransitGatewayPeeringAttachment() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrState: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TransitGatewayAttachmentState_Values(), false),
 			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
 			names.AttrTransitGatewayID: {
@@ -63,8 +71,13 @@ func dataSourceTransitGatewayPeeringAttachment() *schema.Resource {
 func dataSourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	accountID, region := meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region
 
-	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
+	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			names.AttrState: d.Get(names.AttrState).(string),
+		}),
+	}
 
 	input.Filters = append(input.Filters, newCustomFilterList(
 		d.Get(names.AttrFilter).(*schema.Set),
@@ -85,22 +98,42 @@ func dataSourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schem
 		input.Filters = nil
 	}
 
-	transitGatewayPeeringAttachment, err := FindTransitGatewayPeeringAttachment(ctx, conn, input)
+	output, err := FindTransitGatewayPeeringAttachments(ctx, conn, input)
 
 	if err != nil {
-		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Transit Gateway Peering Attachment", err))
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Peering Attachments: %s", err)
 	}
 
-	d.SetId(aws.StringValue(transitGatewayPeeringAttachment.TransitGatewayAttachmentId))
+	// The peer side can't be filtered on server-side as it depends on which side of the attachment the caller is.
+	peerAccountID, peerRegion := d.Get("peer_account_id").(string), d.Get("peer_region").(string)
+	output = tfslices.Filter(output, func(v *ec2.TransitGatewayPeeringAttachment) bool {
+		if v.AccepterTgwInfo == nil || v.RequesterTgwInfo == nil {
+			return false
+		}
+
+		_, peer := transitGatewayPeeringAttachmentLocalAndPeer(v, accountID, region)
+
+		if peerAccountID != "" && aws.StringValue(peer.OwnerId) != peerAccountID {
+			return false
+		}
+
+		if peerRegion != "" && aws.StringValue(peer.Region) != peerRegion {
+			return false
+		}
 
-	local := transitGatewayPeeringAttachment.RequesterTgwInfo
-	peer := transitGatewayPeeringAttachment.AccepterTgwInfo
+		return true
+	})
 
-	if aws.StringValue(transitGatewayPeeringAttachment.AccepterTgwInfo.OwnerId) == meta.(*conns.AWSClient).AccountID && aws.StringValue(transitGatewayPeeringAttachment.AccepterTgwInfo.Region) == meta.(*conns.AWSClient).Region {
-		local = transitGatewayPeeringAttachment.AccepterTgwInfo
-		peer = transitGatewayPeeringAttachment.RequesterTgwInfo
+	transitGatewayPeeringAttachment, err := tfresource.AssertSinglePtrResult(output)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Transit Gateway Peering Attachment", err))
 	}
 
+	d.SetId(aws.StringValue(transitGatewayPeeringAttachment.TransitGatewayAttachmentId))
+
+	local, peer := transitGatewayPeeringAttachmentLocalAndPeer(transitGatewayPeeringAttachment, accountID, region)
+
 	d.Set("peer_account_id", peer.OwnerId)
 	d.Set("peer_region", peer.Region)
 	d.Set("peer_transit_gateway_id", peer.TransitGatewayId)
@@ -111,3 +144,13 @@ func dataSourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schem
 
 	return diags
 }
+
+// transitGatewayPeeringAttachmentLocalAndPeer returns the local and peer sides of the specified attachment
+// as seen from the specified account and Region.
+func transitGatewayPeeringAttachmentLocalAndPeer(v *ec2.TransitGatewayPeeringAttachment, accountID, region string) (*ec2.PeeringTgwInfo, *ec2.PeeringTgwInfo) {
+	if aws.StringValue(v.AccepterTgwInfo.OwnerId) == accountID && aws.StringValue(v.AccepterTgwInfo.Region) == region {
+		return v.AccepterTgwInfo, v.RequesterTgwInfo
+	}
+
+	return v.RequesterTgwInfo, v.AccepterTgwInfo
+}
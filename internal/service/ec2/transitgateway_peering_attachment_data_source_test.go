@@ -4,6 +4,7 @@
 package ec2_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -138,6 +139,39 @@ func testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount(t *tes
 	})
 }
 
+func testAccTransitGatewayPeeringAttachmentDataSource_Peer_differentAccount(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
+	resourceName := "aws_ec2_transit_gateway_peering_attachment.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPeeringAttachmentDataSourceConfig_peerDifferentAccount(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(transitGatewayResourceName, names.AttrOwnerID, dataSourceName, "peer_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", dataSourceName, names.AttrTransitGatewayID),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrState, "pendingAcceptance"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTransitGatewayID, dataSourceName, "peer_transit_gateway_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayPeeringAttachmentDataSource_Tags(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -219,3 +253,17 @@ data "aws_ec2_transit_gateway_peering_attachment" "test" {
 }
 `)
 }
+
+func testAccTransitGatewayPeeringAttachmentDataSourceConfig_peerDifferentAccount(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayPeeringAttachmentConfig_differentAccount(rName), fmt.Sprintf(`
+data "aws_ec2_transit_gateway_peering_attachment" "test" {
+  provider = "awsalternate"
+
+  peer_account_id = aws_ec2_transit_gateway.test.owner_id
+  peer_region     = %[1]q
+  state           = "pendingAcceptance"
+
+  depends_on = [aws_ec2_transit_gateway_peering_attachment.test]
+}
+`, acctest.Region()))
+}
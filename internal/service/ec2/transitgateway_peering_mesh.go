@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_peering_mesh")
+func ResourceTransitGatewayPeeringMesh() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayPeeringMeshCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayPeeringMeshRead,
+		UpdateWithoutTimeout: resourceTransitGatewayPeeringMeshUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayPeeringMeshDelete,
+
+		CustomizeDiff: resourceTransitGatewayPeeringMeshCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"peering_attachment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"accepter_transit_gateway_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"requester_transit_gateway_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"transit_gateway": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"assume_role_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayPeeringMeshCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members, err := expandTransitGatewayPeeringMeshMembers(d.Get("transit_gateway").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	attachments, diags := reconcileTransitGatewayPeeringMesh(ctx, meta, members, tfmaps.Keys(members), nil)
+
+	if err := d.Set("peering_attachment", flattenTransitGatewayPeeringMeshAttachments(attachments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting peering_attachment: %s", err)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceTransitGatewayPeeringMeshRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayPeeringMeshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members, err := expandTransitGatewayPeeringMeshMembers(d.Get("transit_gateway").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var attachments []transitGatewayPeeringMeshAttachment
+
+	for _, v := range expandTransitGatewayPeeringMeshAttachments(d.Get("peering_attachment").([]interface{})) {
+		requester, ok := members[v.requesterARN]
+
+		if !ok {
+			continue
+		}
+
+		output, err := FindTransitGatewayPeeringAttachmentByID(ctx, requester.conn(ctx, meta), v.id)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] EC2 Transit Gateway Peering Attachment (%s) not found, removing from EC2 Transit Gateway Peering Mesh (%s)", v.id, d.Id())
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Peering Attachment (%s): %s", v.id, err)
+		}
+
+		v.state = aws.StringValue(output.State)
+		attachments = append(attachments, v)
+	}
+
+	if err := d.Set("peering_attachment", flattenTransitGatewayPeeringMeshAttachments(attachments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting peering_attachment: %s", err)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayPeeringMeshUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	o, n := d.GetChange("transit_gateway")
+	oldMembers, err := expandTransitGatewayPeeringMeshMembers(o.(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	newMembers, err := expandTransitGatewayPeeringMeshMembers(n.(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Attachments of removed transit gateways are deleted using the previous configuration.
+	members := oldMembers
+	for k, v := range newMembers {
+		members[k] = v
+	}
+
+	o, _ = d.GetChange("peering_attachment")
+	attachments, diags := reconcileTransitGatewayPeeringMesh(ctx, meta, members, tfmaps.Keys(newMembers), expandTransitGatewayPeeringMeshAttachments(o.([]interface{})))
+
+	if err := d.Set("peering_attachment", flattenTransitGatewayPeeringMeshAttachments(attachments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting peering_attachment: %s", err)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, resourceTransitGatewayPeeringMeshRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayPeeringMeshDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	members, err := expandTransitGatewayPeeringMeshMembers(d.Get("transit_gateway").(*schema.Set).List())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, diags = reconcileTransitGatewayPeeringMesh(ctx, meta, members, nil, expandTransitGatewayPeeringMeshAttachments(d.Get("peering_attachment").([]interface{})))
+
+	return diags
+}
+
+func resourceTransitGatewayPeeringMeshCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChange("transit_gateway") {
+		return diff.SetNewComputed("peering_attachment")
+	}
+
+	members, err := expandTransitGatewayPeeringMeshMembers(diff.Get("transit_gateway").(*schema.Set).List())
+
+	if err != nil {
+		return err
+	}
+
+	// Plan an update if any pair of transit gateways isn't peered, e.g. after a peering attachment was deleted outside Terraform.
+	attachments := expandTransitGatewayPeeringMeshAttachments(diff.Get("peering_attachment").([]interface{}))
+	pairs := transitGatewayPeeringMeshPairs(tfmaps.Keys(members))
+
+	if len(attachments) != len(pairs) {
+		return diff.SetNewComputed("peering_attachment")
+	}
+
+	for _, v := range attachments {
+		if v.state != ec2.TransitGatewayAttachmentStateAvailable || !slices.Contains(pairs, v.pair()) {
+			return diff.SetNewComputed("peering_attachment")
+		}
+	}
+
+	return nil
+}
+
+type transitGatewayPeeringMeshMember struct {
+	accountID string
+	region    string
+	roleARN   string
+	tgwID     string
+}
+
+func (m transitGatewayPeeringMeshMember) conn(ctx context.Context, meta interface{}) *ec2.EC2 {
+	return meta.(*conns.AWSClient).EC2ConnForRegionAndRole(ctx, m.region, m.roleARN)
+}
+
+type transitGatewayPeeringMeshAttachment struct {
+	accepterARN  string
+	id           string
+	requesterARN string
+	state        string
+}
+
+func (a transitGatewayPeeringMeshAttachment) pair() [2]string {
+	return [2]string{a.requesterARN, a.accepterARN}
+}
+
+// transitGatewayPeeringMeshPairs returns every pair of transit gateway ARNs.
+// In each pair the first transit gateway, in ARN order, requests the peering attachment.
+func transitGatewayPeeringMeshPairs(arns []string) [][2]string {
+	arns = slices.Clone(arns)
+	slices.Sort(arns)
+
+	var pairs [][2]string
+
+	for i := range arns {
+		for j := i + 1; j < len(arns); j++ {
+			pairs = append(pairs, [2]string{arns[i], arns[j]})
+		}
+	}
+
+	return pairs
+}
+
+// reconcileTransitGatewayPeeringMesh deletes the attachments that don't peer a pair of the specified transit gateways
+// or that aren't available, and then creates and accepts an attachment for each pair without one.
+// The attachments that exist afterwards are returned.
+func reconcileTransitGatewayPeeringMesh(ctx context.Context, meta interface{}, members map[string]transitGatewayPeeringMeshMember, arns []string, attachments []transitGatewayPeeringMeshAttachment) ([]transitGatewayPeeringMeshAttachment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var output []transitGatewayPeeringMeshAttachment
+	pairs := transitGatewayPeeringMeshPairs(arns)
+
+	for _, v := range attachments {
+		if v.state == ec2.TransitGatewayAttachmentStateAvailable && slices.Contains(pairs, v.pair()) {
+			output = append(output, v)
+			continue
+		}
+
+		requester, ok := members[v.requesterARN]
+
+		if !ok {
+			continue
+		}
+
+		if err := deleteTransitGatewayPeeringMeshAttachment(ctx, requester.conn(ctx, meta), v.id); err != nil {
+			diags = sdkdiag.AppendFromErr(diags, err)
+			output = append(output, v)
+		}
+	}
+
+	for _, pair := range pairs {
+		if slices.ContainsFunc(output, func(v transitGatewayPeeringMeshAttachment) bool { return v.pair() == pair }) {
+			continue
+		}
+
+		v, err := createTransitGatewayPeeringMeshAttachment(ctx, meta, pair, members[pair[0]], members[pair[1]])
+
+		if err != nil {
+			diags = sdkdiag.AppendFromErr(diags, err)
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	return output, diags
+}
+
+func createTransitGatewayPeeringMeshAttachment(ctx context.Context, meta interface{}, pair [2]string, requester, accepter transitGatewayPeeringMeshMember) (transitGatewayPeeringMeshAttachment, error) {
+	requesterConn, accepterConn := requester.conn(ctx, meta), accepter.conn(ctx, meta)
+	input := &ec2.CreateTransitGatewayPeeringAttachmentInput{
+		PeerAccountId:        aws.String(accepter.accountID),
+		PeerRegion:           aws.String(accepter.region),
+		PeerTransitGatewayId: aws.String(accepter.tgwID),
+		TransitGatewayId:     aws.String(requester.tgwID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Peering Attachment: %s", input)
+	output, err := requesterConn.CreateTransitGatewayPeeringAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		return transitGatewayPeeringMeshAttachment{}, fmt.Errorf("creating EC2 Transit Gateway Peering Attachment (%s to %s): %w", pair[0], pair[1], err)
+	}
+
+	attachmentID := aws.StringValue(output.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId)
+
+	if _, err := WaitTransitGatewayPeeringAttachmentCreated(ctx, requesterConn, attachmentID); err != nil {
+		err = fmt.Errorf("waiting for EC2 Transit Gateway Peering Attachment (%s) create: %w", attachmentID, err)
+		return transitGatewayPeeringMeshAttachment{}, rollbackTransitGatewayPeeringMeshAttachment(ctx, requesterConn, attachmentID, err)
+	}
+
+	// The attachment may not yet be visible to the accepter.
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return accepterConn.AcceptTransitGatewayPeeringAttachmentWithContext(ctx, &ec2.AcceptTransitGatewayPeeringAttachmentInput{
+			TransitGatewayAttachmentId: aws.String(attachmentID),
+		})
+	}, errCodeInvalidTransitGatewayAttachmentIDNotFound)
+
+	if err != nil {
+		err = fmt.Errorf("accepting EC2 Transit Gateway Peering Attachment (%s): %w", attachmentID, err)
+		return transitGatewayPeeringMeshAttachment{}, rollbackTransitGatewayPeeringMeshAttachment(ctx, requesterConn, attachmentID, err)
+	}
+
+	if _, err := WaitTransitGatewayPeeringAttachmentAccepted(ctx, accepterConn, attachmentID); err != nil {
+		err = fmt.Errorf("waiting for EC2 Transit Gateway Peering Attachment (%s) update: %w", attachmentID, err)
+		return transitGatewayPeeringMeshAttachment{}, rollbackTransitGatewayPeeringMeshAttachment(ctx, requesterConn, attachmentID, err)
+	}
+
+	return transitGatewayPeeringMeshAttachment{
+		accepterARN:  pair[1],
+		id:           attachmentID,
+		requesterARN: pair[0],
+		state:        ec2.TransitGatewayAttachmentStateAvailable,
+	}, nil
+}
+
+// rollbackTransitGatewayPeeringMeshAttachment deletes an attachment that couldn't be accepted so that it isn't left pending.
+func rollbackTransitGatewayPeeringMeshAttachment(ctx context.Context, conn *ec2.EC2, id string, err error) error {
+	if deleteErr := deleteTransitGatewayPeeringMeshAttachment(ctx, conn, id); deleteErr != nil {
+		return fmt.Errorf("%w; %w", err, deleteErr)
+	}
+
+	return err
+}
+
+func deleteTransitGatewayPeeringMeshAttachment(ctx context.Context, conn *ec2.EC2, id string) error {
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Peering Attachment: %s", id)
+	_, err := conn.DeleteTransitGatewayPeeringAttachmentWithContext(ctx, &ec2.DeleteTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(id),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayAttachmentIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting EC2 Transit Gateway Peering Attachment (%s): %w", id, err)
+	}
+
+	if _, err := WaitTransitGatewayPeeringAttachmentDeleted(ctx, conn, id); err != nil {
+		return fmt.Errorf("waiting for EC2 Transit Gateway Peering Attachment (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
+func expandTransitGatewayPeeringMeshMembers(tfList []interface{}) (map[string]transitGatewayPeeringMeshMember, error) {
+	members := make(map[string]transitGatewayPeeringMeshMember)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		v := tfMap[names.AttrARN].(string)
+		parsedARN, err := arn.Parse(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tgwID, ok := strings.CutPrefix(parsedARN.Resource, "transit-gateway/")
+
+		if !ok {
+			return nil, fmt.Errorf("%s is not an EC2 Transit Gateway ARN", v)
+		}
+
+		members[v] = transitGatewayPeeringMeshMember{
+			accountID: parsedARN.AccountID,
+			region:    parsedARN.Region,
+			roleARN:   tfMap["assume_role_arn"].(string),
+			tgwID:     tgwID,
+		}
+	}
+
+	return members, nil
+}
+
+func expandTransitGatewayPeeringMeshAttachments(tfList []interface{}) []transitGatewayPeeringMeshAttachment {
+	var attachments []transitGatewayPeeringMeshAttachment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		attachments = append(attachments, transitGatewayPeeringMeshAttachment{
+			accepterARN:  tfMap["accepter_transit_gateway_arn"].(string),
+			id:           tfMap[names.AttrID].(string),
+			requesterARN: tfMap["requester_transit_gateway_arn"].(string),
+			state:        tfMap[names.AttrState].(string),
+		})
+	}
+
+	return attachments
+}
+
+func flattenTransitGatewayPeeringMeshAttachments(attachments []transitGatewayPeeringMeshAttachment) []interface{} {
+	slices.SortFunc(attachments, func(a, b transitGatewayPeeringMeshAttachment) int {
+		return strings.Compare(a.requesterARN+a.accepterARN, b.requesterARN+b.accepterARN)
+	})
+
+	tfList := make([]interface{}, 0, len(attachments))
+
+	for _, v := range attachments {
+		tfList = append(tfList, map[string]interface{}{
+			"accepter_transit_gateway_arn":  v.accepterARN,
+			names.AttrID:                    v.id,
+			"requester_transit_gateway_arn": v.requesterARN,
+			names.AttrState:                 v.state,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayPeeringMesh_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_peering_mesh.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckTransitGatewayPeeringMeshDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPeeringMeshConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayPeeringMeshExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "peering_attachment.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "peering_attachment.0.state", "available"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayPeeringMeshConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayPeeringMeshExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "peering_attachment.#", "3"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayPeeringMeshConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayPeeringMeshExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transit_gateway.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "peering_attachment.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccTransitGatewayPeeringMeshAttachments calls f for each peering attachment in the mesh with the requester's region.
+func testAccTransitGatewayPeeringMeshAttachments(rs *terraform.ResourceState, f func(client *conns.AWSClient, region, id string) error) error {
+	for i := 0; ; i++ {
+		id, ok := rs.Primary.Attributes[fmt.Sprintf("peering_attachment.%d.id", i)]
+		if !ok {
+			return nil
+		}
+
+		requesterARN, err := arn.Parse(rs.Primary.Attributes[fmt.Sprintf("peering_attachment.%d.requester_transit_gateway_arn", i)])
+		if err != nil {
+			return err
+		}
+
+		if err := f(acctest.Provider.Meta().(*conns.AWSClient), requesterARN.Region, id); err != nil {
+			return err
+		}
+	}
+}
+
+func testAccCheckTransitGatewayPeeringMeshExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		return testAccTransitGatewayPeeringMeshAttachments(rs, func(client *conns.AWSClient, region, id string) error {
+			_, err := tfec2.FindTransitGatewayPeeringAttachmentByID(ctx, client.EC2ConnForRegionAndRole(ctx, region, ""), id)
+
+			return err
+		})
+	}
+}
+
+func testAccCheckTransitGatewayPeeringMeshDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_peering_mesh" {
+				continue
+			}
+
+			err := testAccTransitGatewayPeeringMeshAttachments(rs, func(client *conns.AWSClient, region, id string) error {
+				_, err := tfec2.FindTransitGatewayPeeringAttachmentByID(ctx, client.EC2ConnForRegionAndRole(ctx, region, ""), id)
+
+				if tfresource.NotFound(err) {
+					return nil
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("EC2 Transit Gateway Peering Attachment %s still exists", id)
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayPeeringMeshConfig_basic(rName string, third bool) string {
+	return acctest.ConfigCompose(testAccTransitGatewayPeeringAttachmentConfig_sameAccount_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "third" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+locals {
+  transit_gateway_arns = concat(
+    [aws_ec2_transit_gateway.test.arn, aws_ec2_transit_gateway.peer.arn],
+    %[2]t ? [aws_ec2_transit_gateway.third.arn] : [],
+  )
+}
+
+resource "aws_ec2_transit_gateway_peering_mesh" "test" {
+  dynamic "transit_gateway" {
+    for_each = local.transit_gateway_arns
+
+    content {
+      arn = transit_gateway.value
+    }
+  }
+}
+`, rName, third))
+}
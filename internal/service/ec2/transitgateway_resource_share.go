@@ -0,0 +1,500 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ram"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_resource_share")
+func ResourceTransitGatewayResourceShare() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayResourceShareCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayResourceShareRead,
+		UpdateWithoutTimeout: resourceTransitGatewayResourceShareUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayResourceShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_acceptance", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_external_principals": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"principal_status": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"principals": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTransitGatewayID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"wait_for_acceptance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayResourceShareCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.RAMConn(ctx)
+
+	transitGatewayID := d.Get(names.AttrTransitGatewayID).(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, client.EC2Conn(ctx), transitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	name := d.Get(names.AttrName).(string)
+	transitGatewayARN := aws.StringValue(transitGateway.TransitGatewayArn)
+	principals := flex.ExpandStringValueSet(d.Get("principals").(*schema.Set))
+	input := &ram.CreateResourceShareInput{
+		AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
+		ClientToken:             aws.String(id.UniqueId()),
+		Name:                    aws.String(name),
+		Principals:              aws.StringSlice(principals),
+		ResourceArns:            aws.StringSlice([]string{transitGatewayARN}),
+	}
+
+	output, err := conn.CreateResourceShareWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Resource Share (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.ResourceShare.ResourceShareArn))
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if _, err := waitTransitGatewayResourceShareActive(ctx, conn, d.Id(), timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Resource Share (%s) create: %s", d.Id(), err)
+	}
+
+	if err := waitTransitGatewayResourceShareAssociated(ctx, conn, d.Id(), ram.ResourceShareAssociationTypeResource, []string{transitGatewayARN}, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Resource Share (%s) resource association: %s", d.Id(), err)
+	}
+
+	if err := waitTransitGatewayResourceShareAssociated(ctx, conn, d.Id(), ram.ResourceShareAssociationTypePrincipal, transitGatewayResourceSharePrincipalsToWaitFor(d, principals), timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Resource Share (%s) principal associations: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTransitGatewayResourceShareRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayResourceShareRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	resourceShare, err := findTransitGatewayResourceShareByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Resource Share (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Resource Share (%s): %s", d.Id(), err)
+	}
+
+	resourceAssociations, err := findTransitGatewayResourceShareAssociations(ctx, conn, d.Id(), ram.ResourceShareAssociationTypeResource)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Resource Share (%s) resource associations: %s", d.Id(), err)
+	}
+
+	var transitGatewayID string
+	for _, v := range resourceAssociations {
+		if v, err := arn.Parse(aws.StringValue(v.AssociatedEntity)); err == nil && strings.HasPrefix(v.Resource, "transit-gateway/") {
+			transitGatewayID = strings.TrimPrefix(v.Resource, "transit-gateway/")
+			break
+		}
+	}
+
+	principalAssociations, err := findTransitGatewayResourceShareAssociations(ctx, conn, d.Id(), ram.ResourceShareAssociationTypePrincipal)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Resource Share (%s) principal associations: %s", d.Id(), err)
+	}
+
+	var principals []string
+	principalStatus := make(map[string]string)
+	for _, v := range principalAssociations {
+		principal := aws.StringValue(v.AssociatedEntity)
+		principals = append(principals, principal)
+		principalStatus[principal] = aws.StringValue(v.Status)
+	}
+
+	d.Set("allow_external_principals", resourceShare.AllowExternalPrincipals)
+	d.Set(names.AttrARN, resourceShare.ResourceShareArn)
+	d.Set(names.AttrName, resourceShare.Name)
+	d.Set("principal_status", principalStatus)
+	d.Set("principals", principals)
+	d.Set(names.AttrTransitGatewayID, transitGatewayID)
+
+	return diags
+}
+
+func resourceTransitGatewayResourceShareUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChanges("allow_external_principals", names.AttrName) {
+		input := &ram.UpdateResourceShareInput{
+			AllowExternalPrincipals: aws.Bool(d.Get("allow_external_principals").(bool)),
+			ClientToken:             aws.String(id.UniqueId()),
+			Name:                    aws.String(d.Get(names.AttrName).(string)),
+			ResourceShareArn:        aws.String(d.Id()),
+		}
+
+		_, err := conn.UpdateResourceShareWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Resource Share (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("principals") {
+		o, n := d.GetChange("principals")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if len(del) > 0 {
+			input := &ram.DisassociateResourceShareInput{
+				ClientToken:      aws.String(id.UniqueId()),
+				Principals:       aws.StringSlice(del),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			_, err := conn.DisassociateResourceShareWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "disassociating EC2 Transit Gateway Resource Share (%s) principals: %s", d.Id(), err)
+			}
+
+			if err := waitTransitGatewayResourceShareDisassociated(ctx, conn, d.Id(), ram.ResourceShareAssociationTypePrincipal, del, timeout); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Resource Share (%s) principal disassociations: %s", d.Id(), err)
+			}
+		}
+
+		if len(add) > 0 {
+			input := &ram.AssociateResourceShareInput{
+				ClientToken:      aws.String(id.UniqueId()),
+				Principals:       aws.StringSlice(add),
+				ResourceShareArn: aws.String(d.Id()),
+			}
+
+			_, err := conn.AssociateResourceShareWithContext(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "associating EC2 Transit Gateway Resource Share (%s) principals: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChanges("principals", "wait_for_acceptance") {
+		principals := flex.ExpandStringValueSet(d.Get("principals").(*schema.Set))
+
+		if err := waitTransitGatewayResourceShareAssociated(ctx, conn, d.Id(), ram.ResourceShareAssociationTypePrincipal, transitGatewayResourceSharePrincipalsToWaitFor(d, principals), timeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Resource Share (%s) principal associations: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTransitGatewayResourceShareRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayResourceShareDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RAMConn(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Resource Share: %s", d.Id())
+	_, err := conn.DeleteResourceShareWithContext(ctx, &ram.DeleteResourceShareInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		ResourceShareArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Resource Share (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitTransitGatewayResourceShareDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Resource Share (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// transitGatewayResourceSharePrincipalsToWaitFor returns the principals whose associations are waited for.
+// AWS account principals outside of the organization only become ASSOCIATED once the resource share invitation is accepted,
+// so they are only waited for if `wait_for_acceptance` is set.
+func transitGatewayResourceSharePrincipalsToWaitFor(d *schema.ResourceData, principals []string) []string {
+	if d.Get("wait_for_acceptance").(bool) {
+		return principals
+	}
+
+	var result []string
+	for _, v := range principals {
+		if !itypes.IsAWSAccountID(v) {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func findTransitGatewayResourceShareByARN(ctx context.Context, conn *ram.RAM, arn string) (*ram.ResourceShare, error) {
+	input := &ram.GetResourceSharesInput{
+		ResourceOwner:     aws.String(ram.ResourceOwnerSelf),
+		ResourceShareArns: aws.StringSlice([]string{arn}),
+	}
+
+	output, err := conn.GetResourceSharesWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ram.ErrCodeUnknownResourceException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.ResourceShares) == 0 || output.ResourceShares[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	resourceShare := output.ResourceShares[0]
+
+	if status := aws.StringValue(resourceShare.Status); status == ram.ResourceShareStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return resourceShare, nil
+}
+
+// findTransitGatewayResourceShareAssociations returns the resource share's associations of the specified type
+// that aren't disassociated.
+func findTransitGatewayResourceShareAssociations(ctx context.Context, conn *ram.RAM, arn, associationType string) ([]*ram.ResourceShareAssociation, error) {
+	input := &ram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{arn}),
+	}
+	var output []*ram.ResourceShareAssociation
+
+	err := conn.GetResourceShareAssociationsPagesWithContext(ctx, input, func(page *ram.GetResourceShareAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceShareAssociations {
+			if v != nil && aws.StringValue(v.Status) != ram.ResourceShareAssociationStatusDisassociated {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusTransitGatewayResourceShare(ctx context.Context, conn *ram.RAM, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTransitGatewayResourceShareByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+// statusTransitGatewayResourceShareAssociations returns the aggregate status of the resource share's associations
+// with the specified entities: ASSOCIATING while any association is pending, or ASSOCIATED once all are associated.
+// Entities without an association are reported as DISASSOCIATED only if none of the entities have an association.
+func statusTransitGatewayResourceShareAssociations(ctx context.Context, conn *ram.RAM, arn, associationType string, entities []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		associations, err := findTransitGatewayResourceShareAssociations(ctx, conn, arn, associationType)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		byEntity := make(map[string]*ram.ResourceShareAssociation, len(associations))
+		for _, v := range associations {
+			byEntity[aws.StringValue(v.AssociatedEntity)] = v
+		}
+
+		status, err := transitGatewayResourceShareAssociationsStatus(byEntity, entities)
+
+		return associations, status, err
+	}
+}
+
+// transitGatewayResourceShareAssociationsStatus returns the aggregate status of the associations with the specified entities.
+func transitGatewayResourceShareAssociationsStatus(associations map[string]*ram.ResourceShareAssociation, entities []string) (string, error) {
+	status, found := ram.ResourceShareAssociationStatusAssociated, false
+
+	for _, entity := range entities {
+		v, ok := associations[entity]
+
+		if !ok {
+			status = ram.ResourceShareAssociationStatusAssociating
+			continue
+		}
+
+		found = true
+
+		switch aws.StringValue(v.Status) {
+		case ram.ResourceShareAssociationStatusAssociated:
+		case ram.ResourceShareAssociationStatusFailed:
+			return ram.ResourceShareAssociationStatusFailed, fmt.Errorf("association with %s failed: %s", entity, aws.StringValue(v.StatusMessage))
+		default:
+			status = ram.ResourceShareAssociationStatusAssociating
+		}
+	}
+
+	if !found {
+		return ram.ResourceShareAssociationStatusDisassociated, nil
+	}
+
+	return status, nil
+}
+
+func waitTransitGatewayResourceShareActive(ctx context.Context, conn *ram.RAM, arn string, timeout time.Duration) (*ram.ResourceShare, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{ram.ResourceShareStatusPending},
+		Target:         []string{ram.ResourceShareStatusActive},
+		Refresh:        statusTransitGatewayResourceShare(ctx, conn, arn),
+		Timeout:        timeout,
+		NotFoundChecks: 20,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ram.ResourceShare); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayResourceShareDeleted(ctx context.Context, conn *ram.RAM, arn string, timeout time.Duration) (*ram.ResourceShare, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ram.ResourceShareStatusActive, ram.ResourceShareStatusDeleting},
+		Target:  []string{},
+		Refresh: statusTransitGatewayResourceShare(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ram.ResourceShare); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayResourceShareAssociated(ctx context.Context, conn *ram.RAM, arn, associationType string, entities []string, timeout time.Duration) error {
+	if len(entities) == 0 {
+		return nil
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ram.ResourceShareAssociationStatusAssociating, ram.ResourceShareAssociationStatusDisassociated},
+		Target:  []string{ram.ResourceShareAssociationStatusAssociated},
+		Refresh: statusTransitGatewayResourceShareAssociations(ctx, conn, arn, associationType, entities),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+func waitTransitGatewayResourceShareDisassociated(ctx context.Context, conn *ram.RAM, arn, associationType string, entities []string, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ram.ResourceShareAssociationStatusAssociated, ram.ResourceShareAssociationStatusAssociating},
+		Target:  []string{ram.ResourceShareAssociationStatusDisassociated},
+		Refresh: statusTransitGatewayResourceShareAssociations(ctx, conn, arn, associationType, entities),
+		Timeout: timeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
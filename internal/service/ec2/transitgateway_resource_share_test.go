@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ram"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTransitGatewayResourceShareAssociationsStatus(t *testing.T) {
+	t.Parallel()
+
+	association := func(entity, status string) *ram.ResourceShareAssociation {
+		return &ram.ResourceShareAssociation{
+			AssociatedEntity: aws.String(entity),
+			Status:           aws.String(status),
+			StatusMessage:    aws.String("message"),
+		}
+	}
+
+	testCases := map[string]struct {
+		associations map[string]*ram.ResourceShareAssociation
+		entities     []string
+		want         string
+		wantErr      bool
+	}{
+		"no associations": {
+			entities: []string{"123456789012"},
+			want:     ram.ResourceShareAssociationStatusDisassociated,
+		},
+		"all associated": {
+			associations: map[string]*ram.ResourceShareAssociation{
+				"123456789012": association("123456789012", ram.ResourceShareAssociationStatusAssociated),
+				"210987654321": association("210987654321", ram.ResourceShareAssociationStatusAssociated),
+			},
+			entities: []string{"123456789012", "210987654321"},
+			want:     ram.ResourceShareAssociationStatusAssociated,
+		},
+		"pending acceptance": {
+			associations: map[string]*ram.ResourceShareAssociation{
+				"123456789012": association("123456789012", ram.ResourceShareAssociationStatusAssociated),
+				"210987654321": association("210987654321", ram.ResourceShareAssociationStatusAssociating),
+			},
+			entities: []string{"123456789012", "210987654321"},
+			want:     ram.ResourceShareAssociationStatusAssociating,
+		},
+		"missing association": {
+			associations: map[string]*ram.ResourceShareAssociation{
+				"123456789012": association("123456789012", ram.ResourceShareAssociationStatusAssociated),
+			},
+			entities: []string{"123456789012", "210987654321"},
+			want:     ram.ResourceShareAssociationStatusAssociating,
+		},
+		"disassociating": {
+			associations: map[string]*ram.ResourceShareAssociation{
+				"123456789012": association("123456789012", ram.ResourceShareAssociationStatusDisassociating),
+			},
+			entities: []string{"123456789012"},
+			want:     ram.ResourceShareAssociationStatusAssociating,
+		},
+		"failed": {
+			associations: map[string]*ram.ResourceShareAssociation{
+				"123456789012": association("123456789012", ram.ResourceShareAssociationStatusAssociated),
+				"210987654321": association("210987654321", ram.ResourceShareAssociationStatusFailed),
+			},
+			entities: []string{"123456789012", "210987654321"},
+			want:     ram.ResourceShareAssociationStatusFailed,
+			wantErr:  true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfec2.TransitGatewayResourceShareAssociationsStatus(testCase.associations, testCase.entities)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+
+			if got != testCase.want {
+				t.Errorf("status = %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func testAccTransitGatewayResourceShare_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_transit_gateway_resource_share.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckTransitGatewayResourceShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayResourceShareConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayResourceShareExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_external_principals", "true"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ram", regexache.MustCompile(`resource-share/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "principal_status.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTransitGatewayID, transitGatewayResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_acceptance", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"principal_status"},
+			},
+			{
+				Config: testAccTransitGatewayResourceShareConfig_basic(rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayResourceShareExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "principals.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayResourceShareExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		_, err := tfec2.FindTransitGatewayResourceShareByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckTransitGatewayResourceShareDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RAMConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_transit_gateway_resource_share" {
+				continue
+			}
+
+			_, err := tfec2.FindTransitGatewayResourceShareByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Transit Gateway Resource Share %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayResourceShareConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  auto_accept_shared_attachments = "enable"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_resource_share" "test" {
+  name                      = %[1]q
+  transit_gateway_id        = aws_ec2_transit_gateway.test.id
+  allow_external_principals = true
+  principals                = [data.aws_caller_identity.alternate.account_id]
+}
+`, rName))
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -17,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -29,7 +32,15 @@ func ResourceTransitGatewayRoute() *schema.Resource {
 		ReadWithoutTimeout:   resourceTransitGatewayRouteRead,
 		DeleteWithoutTimeout: resourceTransitGatewayRouteDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_active", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,6 +69,18 @@ func ResourceTransitGatewayRoute() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"verify_propagation_attachment_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -89,6 +112,21 @@ func resourceTransitGatewayRouteCreate(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route (%s) create: %s", d.Id(), err)
 	}
 
+	deadline := time.Now().Add(d.Timeout(schema.TimeoutCreate))
+
+	// Blackhole routes never become active.
+	if d.Get("wait_for_active").(bool) && !d.Get("blackhole").(bool) {
+		if _, err := waitTransitGatewayStaticRouteActive(ctx, conn, transitGatewayRouteTableID, destination, time.Until(deadline)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route (%s) active: %s", d.Id(), err)
+		}
+	}
+
+	if v, ok := d.GetOk("verify_propagation_attachment_ids"); ok && v.(*schema.Set).Len() > 0 {
+		if err := verifyTransitGatewayRoutePropagation(ctx, conn, destination, flex.ExpandStringValueSet(v.(*schema.Set)), time.Until(deadline)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying EC2 Transit Gateway Route (%s) propagation: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceTransitGatewayRouteRead(ctx, d, meta)...)
 }
 
@@ -162,6 +200,128 @@ func resourceTransitGatewayRouteDelete(ctx context.Context, d *schema.ResourceDa
 	return diags
 }
 
+// verifyTransitGatewayRoutePropagation waits for an active route for the destination to appear in the route tables
+// associated with each of the specified attachments.
+func verifyTransitGatewayRoutePropagation(ctx context.Context, conn *ec2.EC2, destination string, transitGatewayAttachmentIDs []string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	slices.Sort(transitGatewayAttachmentIDs)
+
+	for _, transitGatewayAttachmentID := range transitGatewayAttachmentIDs {
+		attachment, err := FindTransitGatewayAttachmentByID(ctx, conn, transitGatewayAttachmentID)
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Transit Gateway Attachment (%s): %w", transitGatewayAttachmentID, err)
+		}
+
+		if attachment.Association == nil || aws.StringValue(attachment.Association.State) != ec2.TransitGatewayAssociationStateAssociated {
+			return fmt.Errorf("EC2 Transit Gateway Attachment (%s) is not associated with a route table", transitGatewayAttachmentID)
+		}
+
+		transitGatewayRouteTableID := aws.StringValue(attachment.Association.TransitGatewayRouteTableId)
+
+		if _, err := waitTransitGatewayRouteActive(ctx, conn, transitGatewayRouteTableID, destination, time.Until(deadline)); err != nil {
+			return fmt.Errorf("waiting for route to %s in EC2 Transit Gateway Route Table (%s) associated with EC2 Transit Gateway Attachment (%s): %w", destination, transitGatewayRouteTableID, transitGatewayAttachmentID, err)
+		}
+	}
+
+	return nil
+}
+
+// deleteTransitGatewayAttachmentStaticRoutes deletes the static routes and prefix list references that target the specified attachment
+// from the transit gateway's route tables, so that deleting the attachment doesn't leave them behind as blackhole routes.
+func deleteTransitGatewayAttachmentStaticRoutes(ctx context.Context, conn *ec2.EC2, transitGatewayID, transitGatewayAttachmentID string) error {
+	routeTables, err := FindTransitGatewayRouteTables(ctx, conn, &ec2.DescribeTransitGatewayRouteTablesInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"transit-gateway-id": transitGatewayID,
+		}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway (%s) Route Tables: %w", transitGatewayID, err)
+	}
+
+	for _, routeTable := range routeTables {
+		transitGatewayRouteTableID := aws.StringValue(routeTable.TransitGatewayRouteTableId)
+		routes, err := FindTransitGatewayRoutes(ctx, conn, &ec2.SearchTransitGatewayRoutesInput{
+			Filters: newAttributeFilterList(map[string]string{
+				"attachment.transit-gateway-attachment-id": transitGatewayAttachmentID,
+				names.AttrType: ec2.TransitGatewayRouteTypeStatic,
+			}),
+			TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EC2 Transit Gateway Route Table (%s) routes: %w", transitGatewayRouteTableID, err)
+		}
+
+		prefixListIDs := make(map[string]struct{})
+
+		for _, route := range routes {
+			if aws.StringValue(route.State) == ec2.TransitGatewayRouteStateDeleted {
+				continue
+			}
+
+			// Routes for prefix list references have no destination CIDR block and are deleted with the reference.
+			if prefixListID := aws.StringValue(route.PrefixListId); prefixListID != "" {
+				if _, ok := prefixListIDs[prefixListID]; ok {
+					continue
+				}
+				prefixListIDs[prefixListID] = struct{}{}
+
+				id := TransitGatewayPrefixListReferenceCreateResourceID(transitGatewayRouteTableID, prefixListID)
+
+				log.Printf("[DEBUG] Deleting EC2 Transit Gateway Prefix List Reference: %s", id)
+				_, err := conn.DeleteTransitGatewayPrefixListReferenceWithContext(ctx, &ec2.DeleteTransitGatewayPrefixListReferenceInput{
+					PrefixListId:               aws.String(prefixListID),
+					TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+				})
+
+				if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+					continue
+				}
+
+				if err != nil {
+					return fmt.Errorf("deleting EC2 Transit Gateway Prefix List Reference (%s): %w", id, err)
+				}
+
+				if _, err := WaitTransitGatewayPrefixListReferenceStateDeleted(ctx, conn, transitGatewayRouteTableID, prefixListID); err != nil {
+					return fmt.Errorf("waiting for EC2 Transit Gateway Prefix List Reference (%s) delete: %w", id, err)
+				}
+
+				continue
+			}
+
+			destination := aws.StringValue(route.DestinationCidrBlock)
+			id := TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, destination)
+
+			log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route: %s", id)
+			_, err := conn.DeleteTransitGatewayRouteWithContext(ctx, &ec2.DeleteTransitGatewayRouteInput{
+				DestinationCidrBlock:       aws.String(destination),
+				TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+			})
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound, errCodeInvalidRouteTableIDNotFound) {
+				continue
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting EC2 Transit Gateway Route (%s): %w", id, err)
+			}
+
+			if _, err := WaitTransitGatewayRouteDeleted(ctx, conn, transitGatewayRouteTableID, destination); err != nil {
+				return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) delete: %w", id, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 const transitGatewayRouteIDSeparator = "_"
 
 func TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, destination string) string {
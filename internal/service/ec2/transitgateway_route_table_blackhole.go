@@ -0,0 +1,397 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_route_table_blackhole")
+func ResourceTransitGatewayRouteTableBlackhole() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTransitGatewayRouteTableBlackholeCreate,
+		ReadWithoutTimeout:   resourceTransitGatewayRouteTableBlackholeRead,
+		UpdateWithoutTimeout: resourceTransitGatewayRouteTableBlackholeUpdate,
+		DeleteWithoutTimeout: resourceTransitGatewayRouteTableBlackholeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTransitGatewayRouteTableBlackholeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"blackhole": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						names.AttrTransitGatewayAttachmentID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayRouteTableBlackholeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayRouteTableID := d.Get("transit_gateway_route_table_id").(string)
+	routes := expandTransitGatewayRouteTargets(d.Get("route").(*schema.Set).List())
+
+	if err := replaceTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, routes, d.Get("blackhole").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway Route Table (%s) Blackhole: %s", transitGatewayRouteTableID, err)
+	}
+
+	d.SetId(transitGatewayRouteTableBlackholeCreateResourceID(transitGatewayRouteTableID, routes))
+
+	return append(diags, resourceTransitGatewayRouteTableBlackholeRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTableBlackholeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayRouteTableID, _, err := transitGatewayRouteTableBlackholeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if _, err := FindTransitGatewayRouteTableByID(ctx, conn, transitGatewayRouteTableID); !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table %s not found, removing from state", transitGatewayRouteTableID)
+		d.SetId("")
+		return diags
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table (%s): %s", transitGatewayRouteTableID, err)
+	}
+
+	configuredBlackhole := d.Get("blackhole").(bool)
+	var routes []interface{}
+	var blackholed, active int
+
+	for _, route := range expandTransitGatewayRouteTargets(d.Get("route").(*schema.Set).List()) {
+		transitGatewayRoute, err := FindTransitGatewayStaticRoute(ctx, conn, transitGatewayRouteTableID, route.destination)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] EC2 Transit Gateway Route (%s) not found, removing from state", TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, route.destination))
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route (%s): %s", TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, route.destination), err)
+		}
+
+		// The attachment of a blackholed route is not known, so retain the configured value.
+		if attachmentID := transitGatewayRouteAttachmentID(transitGatewayRoute); attachmentID != "" {
+			route.transitGatewayAttachmentID = attachmentID
+			active++
+		} else {
+			blackholed++
+		}
+
+		routes = append(routes, flattenTransitGatewayRouteTarget(route))
+	}
+
+	// Any route not in the configured state results in a diff that reapplies it to all routes.
+	if configuredBlackhole {
+		d.Set("blackhole", active == 0)
+	} else {
+		d.Set("blackhole", blackholed > 0)
+	}
+	if err := d.Set("route", routes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+	}
+	d.Set("transit_gateway_route_table_id", transitGatewayRouteTableID)
+
+	return diags
+}
+
+func resourceTransitGatewayRouteTableBlackholeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayRouteTableID, _, err := transitGatewayRouteTableBlackholeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add := expandTransitGatewayRouteTargets(ns.List())
+
+		// Routes no longer managed by this resource are restored to their attachments.
+		var restore []transitGatewayRouteTarget
+		for _, route := range expandTransitGatewayRouteTargets(os.Difference(ns).List()) {
+			if !transitGatewayRouteTargetsContain(add, route.destination) {
+				restore = append(restore, route)
+			}
+		}
+
+		if len(restore) > 0 {
+			if err := replaceTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, restore, false); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table Blackhole (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	routes := expandTransitGatewayRouteTargets(d.Get("route").(*schema.Set).List())
+
+	if err := replaceTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, routes, d.Get("blackhole").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table Blackhole (%s): %s", d.Id(), err)
+	}
+
+	// The ID tracks the managed routes.
+	d.SetId(transitGatewayRouteTableBlackholeCreateResourceID(transitGatewayRouteTableID, routes))
+
+	return append(diags, resourceTransitGatewayRouteTableBlackholeRead(ctx, d, meta)...)
+}
+
+func resourceTransitGatewayRouteTableBlackholeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayRouteTableID, _, err := transitGatewayRouteTableBlackholeParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	var routes []transitGatewayRouteTarget
+	for _, route := range expandTransitGatewayRouteTargets(d.Get("route").(*schema.Set).List()) {
+		// Routes that have since been deleted can't be restored.
+		if _, err := FindTransitGatewayStaticRoute(ctx, conn, transitGatewayRouteTableID, route.destination); tfresource.NotFound(err) {
+			continue
+		} else if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route (%s): %s", TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, route.destination), err)
+		}
+
+		routes = append(routes, route)
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table Blackhole: %s", d.Id())
+	if err := replaceTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, routes, false); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Route Table Blackhole (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayRouteTableBlackholeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	transitGatewayRouteTableID, destinations, err := transitGatewayRouteTableBlackholeParseResourceID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// Read fills in the attachment of each route that isn't blackholed.
+	var routes []interface{}
+	for _, destination := range destinations {
+		routes = append(routes, flattenTransitGatewayRouteTarget(transitGatewayRouteTarget{destination: destination}))
+	}
+
+	d.Set("route", routes)
+	d.Set("transit_gateway_route_table_id", transitGatewayRouteTableID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const (
+	transitGatewayRouteTableBlackholeIDSeparator          = "_"
+	transitGatewayRouteTableBlackholeDestinationSeparator = ","
+)
+
+// transitGatewayRouteTableBlackholeCreateResourceID returns an ID made up of the route table ID and the sorted route destinations,
+// so that resources managing different routes in the same route table have different IDs.
+func transitGatewayRouteTableBlackholeCreateResourceID(transitGatewayRouteTableID string, routes []transitGatewayRouteTarget) string {
+	destinations := make([]string, 0, len(routes))
+	for _, route := range routes {
+		destinations = append(destinations, route.destination)
+	}
+	slices.Sort(destinations)
+
+	parts := []string{transitGatewayRouteTableID, strings.Join(destinations, transitGatewayRouteTableBlackholeDestinationSeparator)}
+	id := strings.Join(parts, transitGatewayRouteTableBlackholeIDSeparator)
+
+	return id
+}
+
+func transitGatewayRouteTableBlackholeParseResourceID(id string) (string, []string, error) {
+	parts := strings.SplitN(id, transitGatewayRouteTableBlackholeIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		destinations := strings.Split(parts[1], transitGatewayRouteTableBlackholeDestinationSeparator)
+
+		if !slices.Contains(destinations, "") {
+			return parts[0], destinations, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("unexpected format for ID (%[1]s), expected TRANSIT-GATEWAY-ROUTE-TABLE-ID%[2]sDESTINATION[%[3]sDESTINATION...]", id, transitGatewayRouteTableBlackholeIDSeparator, transitGatewayRouteTableBlackholeDestinationSeparator)
+}
+
+type transitGatewayRouteTarget struct {
+	destination                string
+	transitGatewayAttachmentID string
+	blackhole                  bool
+}
+
+// replaceTransitGatewayRoutes points each of the specified static routes at either a blackhole or its attachment.
+// The change is applied to all routes or to none of them: if any replacement fails, the routes already replaced are reverted.
+func replaceTransitGatewayRoutes(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID string, routes []transitGatewayRouteTarget, blackhole bool) error {
+	var replaced []transitGatewayRouteTarget
+
+	for _, route := range routes {
+		transitGatewayRoute, err := FindTransitGatewayStaticRoute(ctx, conn, transitGatewayRouteTableID, route.destination)
+
+		if err != nil {
+			err = fmt.Errorf("reading EC2 Transit Gateway Route (%s): %w", TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, route.destination), err)
+
+			return errors.Join(err, revertTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, replaced))
+		}
+
+		previous := transitGatewayRouteTarget{
+			destination:                route.destination,
+			transitGatewayAttachmentID: transitGatewayRouteAttachmentID(transitGatewayRoute),
+		}
+		previous.blackhole = previous.transitGatewayAttachmentID == ""
+
+		if previous.blackhole == blackhole && (blackhole || previous.transitGatewayAttachmentID == route.transitGatewayAttachmentID) {
+			continue
+		}
+
+		route.blackhole = blackhole
+		if err := replaceTransitGatewayRoute(ctx, conn, transitGatewayRouteTableID, route); err != nil {
+			return errors.Join(err, revertTransitGatewayRoutes(ctx, conn, transitGatewayRouteTableID, replaced))
+		}
+
+		replaced = append(replaced, previous)
+	}
+
+	return nil
+}
+
+func revertTransitGatewayRoutes(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID string, routes []transitGatewayRouteTarget) error {
+	var errs []error
+
+	for _, route := range routes {
+		log.Printf("[WARN] Reverting EC2 Transit Gateway Route (%s)", TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, route.destination))
+		if err := replaceTransitGatewayRoute(ctx, conn, transitGatewayRouteTableID, route); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func replaceTransitGatewayRoute(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID string, route transitGatewayRouteTarget) error {
+	id := TransitGatewayRouteCreateResourceID(transitGatewayRouteTableID, route.destination)
+	input := &ec2.ReplaceTransitGatewayRouteInput{
+		DestinationCidrBlock:       aws.String(route.destination),
+		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
+	}
+
+	state := ec2.TransitGatewayRouteStateActive
+	if route.blackhole {
+		input.Blackhole = aws.Bool(true)
+		state = ec2.TransitGatewayRouteStateBlackhole
+	} else {
+		input.TransitGatewayAttachmentId = aws.String(route.transitGatewayAttachmentID)
+	}
+
+	log.Printf("[DEBUG] Replacing EC2 Transit Gateway Route: %s", input)
+	if _, err := conn.ReplaceTransitGatewayRouteWithContext(ctx, input); err != nil {
+		return fmt.Errorf("replacing EC2 Transit Gateway Route (%s): %w", id, err)
+	}
+
+	if _, err := WaitTransitGatewayRouteReplaced(ctx, conn, transitGatewayRouteTableID, route.destination, state); err != nil {
+		return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) replace: %w", id, err)
+	}
+
+	return nil
+}
+
+func transitGatewayRouteAttachmentID(apiObject *ec2.TransitGatewayRoute) string {
+	if aws.StringValue(apiObject.State) == ec2.TransitGatewayRouteStateBlackhole {
+		return ""
+	}
+
+	if len(apiObject.TransitGatewayAttachments) > 0 && apiObject.TransitGatewayAttachments[0] != nil {
+		return aws.StringValue(apiObject.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+	}
+
+	return ""
+}
+
+func transitGatewayRouteTargetsContain(routes []transitGatewayRouteTarget, destination string) bool {
+	for _, route := range routes {
+		if types.CIDRBlocksEqual(route.destination, destination) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandTransitGatewayRouteTargets(tfList []interface{}) []transitGatewayRouteTarget {
+	var routes []transitGatewayRouteTarget
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		routes = append(routes, transitGatewayRouteTarget{
+			destination:                tfMap["destination_cidr_block"].(string),
+			transitGatewayAttachmentID: tfMap[names.AttrTransitGatewayAttachmentID].(string),
+		})
+	}
+
+	return routes
+}
+
+func flattenTransitGatewayRouteTarget(route transitGatewayRouteTarget) map[string]interface{} {
+	return map[string]interface{}{
+		"destination_cidr_block":             route.destination,
+		names.AttrTransitGatewayAttachmentID: route.transitGatewayAttachmentID,
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayRouteTableBlackhole_basic(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var v1, v2 ec2.TransitGatewayRoute
+	resourceName := "aws_ec2_transit_gateway_route_table_blackhole.test"
+	route1ResourceName := "aws_ec2_transit_gateway_route.test1"
+	route2ResourceName := "aws_ec2_transit_gateway_route.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableBlackholeConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteExists(ctx, route1ResourceName, &v1),
+					testAccCheckTransitGatewayRouteExists(ctx, route2ResourceName, &v2),
+					testAccCheckTransitGatewayRouteState(&v1, ec2.TransitGatewayRouteStateBlackhole),
+					testAccCheckTransitGatewayRouteState(&v2, ec2.TransitGatewayRouteStateBlackhole),
+					resource.TestCheckResourceAttr(resourceName, "blackhole", "true"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", "aws_ec2_transit_gateway.test", "association_default_route_table_id"),
+					resource.TestMatchResourceAttr(resourceName, names.AttrID, regexache.MustCompile(`^tgw-rtb-[0-9a-f]+_192\.0\.2\.0/24,198\.51\.100\.0/24$`)),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableBlackholeConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteExists(ctx, route1ResourceName, &v1),
+					testAccCheckTransitGatewayRouteExists(ctx, route2ResourceName, &v2),
+					testAccCheckTransitGatewayRouteState(&v1, ec2.TransitGatewayRouteStateActive),
+					testAccCheckTransitGatewayRouteState(&v2, ec2.TransitGatewayRouteStateActive),
+					resource.TestCheckResourceAttr(resourceName, "blackhole", "false"),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTableBlackholeConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteExists(ctx, route1ResourceName, &v1),
+					testAccCheckTransitGatewayRouteState(&v1, ec2.TransitGatewayRouteStateBlackhole),
+					resource.TestCheckResourceAttr(resourceName, "blackhole", "true"),
+				),
+			},
+			{
+				// Removing the resource restores the routes to their attachments.
+				Config: testAccTransitGatewayRouteTableBlackholeConfig_routes(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTransitGatewayRouteExists(ctx, route1ResourceName, &v1),
+					testAccCheckTransitGatewayRouteExists(ctx, route2ResourceName, &v2),
+					testAccCheckTransitGatewayRouteState(&v1, ec2.TransitGatewayRouteStateActive),
+					testAccCheckTransitGatewayRouteState(&v2, ec2.TransitGatewayRouteStateActive),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTransitGatewayRouteState(v *ec2.TransitGatewayRoute, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(v.State); got != want {
+			return fmt.Errorf("EC2 Transit Gateway Route (%s) state: got %s, want %s", aws.StringValue(v.DestinationCidrBlock), got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccTransitGatewayRouteTableBlackholeConfig_routes(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route" "test1" {
+  destination_cidr_block         = "192.0.2.0/24"
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+
+  lifecycle {
+    ignore_changes = [blackhole, transit_gateway_attachment_id]
+  }
+}
+
+resource "aws_ec2_transit_gateway_route" "test2" {
+  destination_cidr_block         = "198.51.100.0/24"
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+
+  lifecycle {
+    ignore_changes = [blackhole, transit_gateway_attachment_id]
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableBlackholeConfig_basic(rName string, blackhole bool) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableBlackholeConfig_routes(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table_blackhole" "test" {
+  transit_gateway_route_table_id = aws_ec2_transit_gateway.test.association_default_route_table_id
+  blackhole                      = %[1]t
+
+  dynamic "route" {
+    for_each = [aws_ec2_transit_gateway_route.test1, aws_ec2_transit_gateway_route.test2]
+
+    content {
+      destination_cidr_block        = route.value.destination_cidr_block
+      transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+    }
+  }
+}
+`, blackhole))
+}
@@ -44,9 +44,20 @@ func DataSourceTransitGatewayRouteTable() *schema.Resource {
 			},
 			names.AttrFilter: customFiltersSchema(),
 			names.AttrID: {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{names.AttrTransitGatewayAttachmentID},
+			},
+			"propagation_route_table_ids": {
+				Type:     schema.TypeList,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTransitGatewayAttachmentID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{names.AttrID},
 			},
 			names.AttrTransitGatewayID: {
 				Type:     schema.TypeString,
@@ -77,6 +88,37 @@ func dataSourceTransitGatewayRouteTableRead(ctx context.Context, d *schema.Resou
 		input.TransitGatewayRouteTableIds = aws.StringSlice([]string{v.(string)})
 	}
 
+	var propagationRouteTableIDs []string
+	if v, ok := d.GetOk(names.AttrTransitGatewayAttachmentID); ok {
+		transitGatewayAttachmentID := v.(string)
+		transitGatewayAttachment, err := FindTransitGatewayAttachmentByID(ctx, conn, transitGatewayAttachmentID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Attachment (%s): %s", transitGatewayAttachmentID, err)
+		}
+
+		association := transitGatewayAttachment.Association
+		if association == nil || aws.StringValue(association.State) != ec2.TransitGatewayAssociationStateAssociated {
+			return sdkdiag.AppendErrorf(diags, "EC2 Transit Gateway Attachment (%s) is not associated with a Transit Gateway Route Table", transitGatewayAttachmentID)
+		}
+
+		input.TransitGatewayRouteTableIds = aws.StringSlice([]string{aws.StringValue(association.TransitGatewayRouteTableId)})
+
+		propagations, err := FindTransitGatewayAttachmentPropagations(ctx, conn, &ec2.GetTransitGatewayAttachmentPropagationsInput{
+			TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Attachment (%s) propagations: %s", transitGatewayAttachmentID, err)
+		}
+
+		for _, v := range propagations {
+			if aws.StringValue(v.State) == ec2.TransitGatewayPropagationStateEnabled {
+				propagationRouteTableIDs = append(propagationRouteTableIDs, aws.StringValue(v.TransitGatewayRouteTableId))
+			}
+		}
+	}
+
 	transitGatewayRouteTable, err := FindTransitGatewayRouteTable(ctx, conn, input)
 
 	if err != nil {
@@ -94,6 +136,7 @@ func dataSourceTransitGatewayRouteTableRead(ctx context.Context, d *schema.Resou
 	d.Set(names.AttrARN, arn)
 	d.Set("default_association_route_table", transitGatewayRouteTable.DefaultAssociationRouteTable)
 	d.Set("default_propagation_route_table", transitGatewayRouteTable.DefaultPropagationRouteTable)
+	d.Set("propagation_route_table_ids", propagationRouteTableIDs)
 	d.Set(names.AttrTransitGatewayID, transitGatewayRouteTable.TransitGatewayId)
 
 	if err := d.Set(names.AttrTags, KeyValueTags(ctx, transitGatewayRouteTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -74,6 +74,37 @@ func testAccTransitGatewayRouteTableDataSource_ID(t *testing.T, semaphore tfsync
 	})
 }
 
+func testAccTransitGatewayRouteTableDataSource_transitGatewayAttachmentID(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table.test"
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	propagationResourceName := "aws_ec2_transit_gateway_route_table.propagation"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableDataSourceConfig_transitGatewayAttachmentID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrARN, dataSourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, dataSourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "propagation_route_table_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(propagationResourceName, names.AttrID, dataSourceName, "propagation_route_table_ids.0"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTransitGatewayID, dataSourceName, names.AttrTransitGatewayID),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayRouteTableDataSourceConfig_filter(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -120,3 +151,60 @@ data "aws_ec2_transit_gateway_route_table" "test" {
 }
 `, rName)
 }
+
+func testAccTransitGatewayRouteTableDataSourceConfig_transitGatewayAttachmentID(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "propagation" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids                                      = aws_subnet.test[*].id
+  transit_gateway_default_route_table_association = false
+  transit_gateway_default_route_table_propagation = false
+  transit_gateway_id                              = aws_ec2_transit_gateway.test.id
+  vpc_id                                          = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table_association" "test" {
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+
+resource "aws_ec2_transit_gateway_route_table_propagation" "test" {
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.propagation.id
+}
+
+data "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  depends_on = [
+    aws_ec2_transit_gateway_route_table_association.test,
+    aws_ec2_transit_gateway_route_table_propagation.test,
+  ]
+}
+`, rName))
+}
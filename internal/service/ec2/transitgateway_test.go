@@ -57,6 +57,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"DefaultRouteTablePropagation":                       testAccTransitGateway_DefaultRouteTablePropagation,
 			"Description":                                        testAccTransitGateway_Description,
 			"DnsSupport":                                         testAccTransitGateway_DNSSupport,
+			"SecurityGroupReferencingSupport":                    testAccTransitGateway_SecurityGroupReferencingSupport,
 			"VpnEcmpSupport":                                     testAccTransitGateway_VPNECMPSupport,
 		},
 		"MulticastDomain": {
@@ -93,6 +94,9 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			names.AttrTags:     testAccTransitGatewayPeeringAttachmentAccepter_tags,
 			"DifferentAccount": testAccTransitGatewayPeeringAttachmentAccepter_differentAccount,
 		},
+		"PeeringMesh": {
+			"basic": testAccTransitGatewayPeeringMesh_basic,
+		},
 		"PolicyTable": {
 			"basic":                    testAccTransitGatewayPolicyTable_basic,
 			"disappears":               testAccTransitGatewayPolicyTable_disappears,
@@ -109,12 +113,16 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"disappearsTransitGateway":   testAccTransitGatewayPrefixListReference_disappears_TransitGateway,
 			"TransitGatewayAttachmentId": testAccTransitGatewayPrefixListReference_TransitGatewayAttachmentID,
 		},
+		"ResourceShare": {
+			"basic": testAccTransitGatewayResourceShare_basic,
+		},
 		"Route": {
 			"basic":                              testAccTransitGatewayRoute_basic,
 			"basicIpv6":                          testAccTransitGatewayRoute_basic_ipv6,
 			"blackhole":                          testAccTransitGatewayRoute_blackhole,
 			"disappears":                         testAccTransitGatewayRoute_disappears,
 			"disappearsTransitGatewayAttachment": testAccTransitGatewayRoute_disappears_TransitGatewayAttachment,
+			"waitForActive":                      testAccTransitGatewayRoute_waitForActive,
 		},
 		"RouteTable": {
 			"basic":                    testAccTransitGatewayRouteTable_basic,
@@ -127,19 +135,25 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"disappears":                 testAccTransitGatewayRouteTableAssociation_disappears,
 			"ReplaceExistingAssociation": testAccTransitGatewayRouteTableAssociation_replaceExistingAssociation,
 		},
+		"RouteTableBlackhole": {
+			"basic": testAccTransitGatewayRouteTableBlackhole_basic,
+		},
 		"RouteTablePropagation": {
 			"basic":      testAccTransitGatewayRouteTablePropagation_basic,
 			"disappears": testAccTransitGatewayRouteTablePropagation_disappears,
 		},
 		"VpcAttachment": {
-			"basic":                testAccTransitGatewayVPCAttachment_basic,
-			"disappears":           testAccTransitGatewayVPCAttachment_disappears,
-			names.AttrTags:         testAccTransitGatewayVPCAttachment_tags,
-			"ApplianceModeSupport": testAccTransitGatewayVPCAttachment_ApplianceModeSupport,
-			"DnsSupport":           testAccTransitGatewayVPCAttachment_DNSSupport,
-			"Ipv6Support":          testAccTransitGatewayVPCAttachment_IPv6Support,
-			"SharedTransitGateway": testAccTransitGatewayVPCAttachment_SharedTransitGateway,
-			"SubnetIds":            testAccTransitGatewayVPCAttachment_SubnetIDs,
+			"basic":                           testAccTransitGatewayVPCAttachment_basic,
+			"disappears":                      testAccTransitGatewayVPCAttachment_disappears,
+			names.AttrTags:                    testAccTransitGatewayVPCAttachment_tags,
+			"ApplianceModeSupport":            testAccTransitGatewayVPCAttachment_ApplianceModeSupport,
+			"DnsSupport":                      testAccTransitGatewayVPCAttachment_DNSSupport,
+			"Ipv6Only":                        testAccTransitGatewayVPCAttachment_IPv6Only,
+			"Ipv6Support":                     testAccTransitGatewayVPCAttachment_IPv6Support,
+			"SecurityGroupReferencingSupport": testAccTransitGatewayVPCAttachment_SecurityGroupReferencingSupport,
+			"SharedTransitGateway":            testAccTransitGatewayVPCAttachment_SharedTransitGateway,
+			"SubnetIds":                       testAccTransitGatewayVPCAttachment_SubnetIDs,
+			"CleanupRoutes":                   testAccTransitGatewayVPCAttachment_cleanupRoutes,
 			"TransitGatewayDefaultRouteTableAssociation":                       testAccTransitGatewayVPCAttachment_TransitGatewayDefaultRouteTableAssociation,
 			"TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled": testAccTransitGatewayVPCAttachment_TransitGatewayDefaultRouteTableAssociationAndPropagationDisabled,
 			"TransitGatewayDefaultRouteTablePropagation":                       testAccTransitGatewayVPCAttachment_TransitGatewayDefaultRouteTablePropagation,
@@ -530,6 +544,46 @@ func testAccTransitGateway_DNSSupport(t *testing.T, semaphore tfsync.Semaphore)
 	})
 }
 
+func testAccTransitGateway_SecurityGroupReferencingSupport(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var transitGateway1, transitGateway2 ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayConfig_securityGroupReferencingSupport(rName, ec2.SecurityGroupReferencingSupportValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, resourceName, &transitGateway1),
+					resource.TestCheckResourceAttr(resourceName, "security_group_referencing_support", ec2.SecurityGroupReferencingSupportValueEnable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayConfig_securityGroupReferencingSupport(rName, ec2.SecurityGroupReferencingSupportValueDisable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, resourceName, &transitGateway2),
+					testAccCheckTransitGatewayNotRecreated(&transitGateway1, &transitGateway2),
+					resource.TestCheckResourceAttr(resourceName, "security_group_referencing_support", ec2.SecurityGroupReferencingSupportValueDisable),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGateway_VPNECMPSupport(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGateway1, transitGateway2 ec2.TransitGateway
@@ -985,6 +1039,18 @@ resource "aws_ec2_transit_gateway" "test" {
 `, rName, dnsSupport)
 }
 
+func testAccTransitGatewayConfig_securityGroupReferencingSupport(rName, securityGroupReferencingSupport string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  security_group_referencing_support = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, securityGroupReferencingSupport)
+}
+
 func testAccTransitGatewayConfig_vpnECMPSupport(rName, vpnEcmpSupport string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -44,6 +45,10 @@ func ResourceTransitGatewayVPCAttachment() *schema.Resource {
 				Default:      ec2.ApplianceModeSupportValueDisable,
 				ValidateFunc: validation.StringInSlice(ec2.ApplianceModeSupportValue_Values(), false),
 			},
+			"cleanup_routes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"dns_support": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -56,6 +61,12 @@ func ResourceTransitGatewayVPCAttachment() *schema.Resource {
 				Default:      ec2.Ipv6SupportValueDisable,
 				ValidateFunc: validation.StringInSlice(ec2.Ipv6SupportValue_Values(), false),
 			},
+			"security_group_referencing_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.SecurityGroupReferencingSupportValue_Values(), false),
+			},
 			names.AttrSubnetIDs: {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -111,6 +122,14 @@ func resourceTransitGatewayVPCAttachmentCreate(ctx context.Context, d *schema.Re
 		VpcId:             aws.String(d.Get(names.AttrVPCID).(string)),
 	}
 
+	if v, ok := d.GetOk("security_group_referencing_support"); ok {
+		input.Options.SecurityGroupReferencingSupport = aws.String(v.(string))
+	}
+
+	if err := validateTransitGatewayVPCAttachmentIPv6Support(ctx, conn, aws.StringValue(input.Options.Ipv6Support), input.SubnetIds); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Transit Gateway VPC Attachment: %s", err)
+	}
+
 	log.Printf("[DEBUG] Creating EC2 Transit Gateway VPC Attachment: %s", input)
 	output, err := conn.CreateTransitGatewayVpcAttachmentWithContext(ctx, input)
 
@@ -211,6 +230,7 @@ func resourceTransitGatewayVPCAttachmentRead(ctx context.Context, d *schema.Reso
 	d.Set("appliance_mode_support", transitGatewayVPCAttachment.Options.ApplianceModeSupport)
 	d.Set("dns_support", transitGatewayVPCAttachment.Options.DnsSupport)
 	d.Set("ipv6_support", transitGatewayVPCAttachment.Options.Ipv6Support)
+	d.Set("security_group_referencing_support", transitGatewayVPCAttachment.Options.SecurityGroupReferencingSupport)
 	d.Set(names.AttrSubnetIDs, aws.StringValueSlice(transitGatewayVPCAttachment.SubnetIds))
 	d.Set("transit_gateway_default_route_table_association", transitGatewayDefaultRouteTableAssociation)
 	d.Set("transit_gateway_default_route_table_propagation", transitGatewayDefaultRouteTablePropagation)
@@ -227,7 +247,7 @@ func resourceTransitGatewayVPCAttachmentUpdate(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
-	if d.HasChanges("appliance_mode_support", "dns_support", "ipv6_support", names.AttrSubnetIDs) {
+	if d.HasChanges("appliance_mode_support", "dns_support", "ipv6_support", "security_group_referencing_support", names.AttrSubnetIDs) {
 		input := &ec2.ModifyTransitGatewayVpcAttachmentInput{
 			Options: &ec2.ModifyTransitGatewayVpcAttachmentRequestOptions{
 				ApplianceModeSupport: aws.String(d.Get("appliance_mode_support").(string)),
@@ -237,6 +257,10 @@ func resourceTransitGatewayVPCAttachmentUpdate(ctx context.Context, d *schema.Re
 			TransitGatewayAttachmentId: aws.String(d.Id()),
 		}
 
+		if v, ok := d.GetOk("security_group_referencing_support"); ok {
+			input.Options.SecurityGroupReferencingSupport = aws.String(v.(string))
+		}
+
 		o, n := d.GetChange(names.AttrSubnetIDs)
 		os := o.(*schema.Set)
 		ns := n.(*schema.Set)
@@ -249,6 +273,12 @@ func resourceTransitGatewayVPCAttachmentUpdate(ctx context.Context, d *schema.Re
 			input.RemoveSubnetIds = flex.ExpandStringSet(del)
 		}
 
+		if d.HasChanges("ipv6_support", names.AttrSubnetIDs) {
+			if err := validateTransitGatewayVPCAttachmentIPv6Support(ctx, conn, aws.StringValue(input.Options.Ipv6Support), flex.ExpandStringSet(ns)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
+			}
+		}
+
 		if _, err := conn.ModifyTransitGatewayVpcAttachmentWithContext(ctx, input); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
 		}
@@ -286,6 +316,12 @@ func resourceTransitGatewayVPCAttachmentDelete(ctx context.Context, d *schema.Re
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
 
+	if d.Get("cleanup_routes").(bool) {
+		if err := deleteTransitGatewayAttachmentStaticRoutes(ctx, conn, d.Get(names.AttrTransitGatewayID).(string), d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway VPC Attachment (%s): %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting EC2 Transit Gateway VPC Attachment: %s", d.Id())
 	_, err := conn.DeleteTransitGatewayVpcAttachmentWithContext(ctx, &ec2.DeleteTransitGatewayVpcAttachmentInput{
 		TransitGatewayAttachmentId: aws.String(d.Id()),
@@ -305,3 +341,28 @@ func resourceTransitGatewayVPCAttachmentDelete(ctx context.Context, d *schema.Re
 
 	return diags
 }
+
+// validateTransitGatewayVPCAttachmentIPv6Support returns an error if any of the specified subnets
+// is IPv6-only and IPv6 support is not enabled for the attachment.
+// Without IPv6 support the attachment has no addresses in an IPv6-only subnet and cannot route traffic.
+func validateTransitGatewayVPCAttachmentIPv6Support(ctx context.Context, conn *ec2.EC2, ipv6Support string, subnetIDs []*string) error {
+	if ipv6Support == ec2.Ipv6SupportValueEnable || len(subnetIDs) == 0 {
+		return nil
+	}
+
+	subnets, err := FindSubnets(ctx, conn, &ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	})
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Subnets: %w", err)
+	}
+
+	for _, subnet := range subnets {
+		if aws.BoolValue(subnet.Ipv6Native) {
+			return fmt.Errorf("subnet (%s) is IPv6-only; ipv6_support must be %q", aws.StringValue(subnet.SubnetId), ec2.Ipv6SupportValueEnable)
+		}
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -172,6 +173,46 @@ func testAccTransitGatewayVPCAttachment_DNSSupport(t *testing.T, semaphore tfsyn
 	})
 }
 
+func testAccTransitGatewayVPCAttachment_SecurityGroupReferencingSupport(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var transitGatewayVpcAttachment1, transitGatewayVpcAttachment2 ec2.TransitGatewayVpcAttachment
+	resourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGatewayVPCAttachment(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayVPCAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayVPCAttachmentConfig_securityGroupReferencingSupport(rName, ec2.SecurityGroupReferencingSupportValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayVPCAttachmentExists(ctx, resourceName, &transitGatewayVpcAttachment1),
+					resource.TestCheckResourceAttr(resourceName, "security_group_referencing_support", ec2.SecurityGroupReferencingSupportValueEnable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayVPCAttachmentConfig_securityGroupReferencingSupport(rName, ec2.SecurityGroupReferencingSupportValueDisable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayVPCAttachmentExists(ctx, resourceName, &transitGatewayVpcAttachment2),
+					testAccCheckTransitGatewayVPCAttachmentNotRecreated(&transitGatewayVpcAttachment1, &transitGatewayVpcAttachment2),
+					resource.TestCheckResourceAttr(resourceName, "security_group_referencing_support", ec2.SecurityGroupReferencingSupportValueDisable),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayVPCAttachment_IPv6Support(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayVpcAttachment1, transitGatewayVpcAttachment2 ec2.TransitGatewayVpcAttachment
@@ -212,6 +253,43 @@ func testAccTransitGatewayVPCAttachment_IPv6Support(t *testing.T, semaphore tfsy
 	})
 }
 
+func testAccTransitGatewayVPCAttachment_IPv6Only(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var transitGatewayVpcAttachment ec2.TransitGatewayVpcAttachment
+	resourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGatewayVPCAttachment(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayVPCAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTransitGatewayVPCAttachmentConfig_ipv6Only(rName, ec2.Ipv6SupportValueDisable),
+				ExpectError: regexache.MustCompile(`is IPv6-only; ipv6_support must be "enable"`),
+			},
+			{
+				Config: testAccTransitGatewayVPCAttachmentConfig_ipv6Only(rName, ec2.Ipv6SupportValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayVPCAttachmentExists(ctx, resourceName, &transitGatewayVpcAttachment),
+					resource.TestCheckResourceAttr(resourceName, "ipv6_support", ec2.Ipv6SupportValueEnable),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayVPCAttachment_SharedTransitGateway(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayVpcAttachment1 ec2.TransitGatewayVpcAttachment
@@ -245,6 +323,42 @@ func testAccTransitGatewayVPCAttachment_SharedTransitGateway(t *testing.T, semap
 	})
 }
 
+func testAccTransitGatewayVPCAttachment_cleanupRoutes(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	var transitGatewayVpcAttachment1 ec2.TransitGatewayVpcAttachment
+	resourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGatewayVPCAttachment(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayVPCAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayVPCAttachmentConfig_cleanupRoutes(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayVPCAttachmentExists(ctx, resourceName, &transitGatewayVpcAttachment1),
+					resource.TestCheckResourceAttr(resourceName, "cleanup_routes", "true"),
+					// Create a static route outside of Terraform, so it's still there when the attachment is deleted.
+					testAccCheckTransitGatewayRouteCreate(ctx, transitGatewayRouteTableResourceName, resourceName, "10.100.0.0/16"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayVPCAttachmentConfig_cleanupRoutes(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableNoStaticRoutes(ctx, transitGatewayRouteTableResourceName),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayVPCAttachment_SubnetIDs(t *testing.T, semaphore tfsync.Semaphore) {
 	ctx := acctest.Context(t)
 	var transitGatewayVpcAttachment1, transitGatewayVpcAttachment2, transitGatewayVpcAttachment3 ec2.TransitGatewayVpcAttachment
@@ -520,6 +634,67 @@ func testAccCheckTransitGatewayVPCAttachmentExists(ctx context.Context, n string
 	}
 }
 
+func testAccCheckTransitGatewayRouteCreate(ctx context.Context, routeTableResourceName, attachmentResourceName, destination string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		routeTable, ok := s.RootModule().Resources[routeTableResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", routeTableResourceName)
+		}
+
+		attachment, ok := s.RootModule().Resources[attachmentResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", attachmentResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := conn.CreateTransitGatewayRouteWithContext(ctx, &ec2.CreateTransitGatewayRouteInput{
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayAttachmentId: aws.String(attachment.Primary.ID),
+			TransitGatewayRouteTableId: aws.String(routeTable.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfec2.WaitTransitGatewayRouteCreated(ctx, conn, routeTable.Primary.ID, destination)
+
+		return err
+	}
+}
+
+func testAccCheckTransitGatewayRouteTableNoStaticRoutes(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindTransitGatewayRoutes(ctx, conn, &ec2.SearchTransitGatewayRoutesInput{
+			Filters: []*ec2.Filter{{
+				Name:   aws.String(names.AttrType),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayRouteTypeStatic}),
+			}},
+			TransitGatewayRouteTableId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output {
+			if state := aws.StringValue(v.State); state != ec2.TransitGatewayRouteStateDeleted {
+				return fmt.Errorf("EC2 Transit Gateway Route Table (%s) static route (%s) still exists in state %s", rs.Primary.ID, aws.StringValue(v.DestinationCidrBlock), state)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTransitGatewayVPCAttachmentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
@@ -576,6 +751,35 @@ resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
 `)
 }
 
+func testAccTransitGatewayVPCAttachmentConfig_cleanupRoutes(rName string, attachment bool) string {
+	config := acctest.ConfigCompose(testAccTransitGatewayVPCAttachmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+
+	if !attachment {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  cleanup_routes     = true
+  subnet_ids         = aws_subnet.test[*].id
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccTransitGatewayVPCAttachmentConfig_applianceModeSupport(rName, appModeSupport string) string {
 	return acctest.ConfigCompose(testAccTransitGatewayVPCAttachmentConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
@@ -606,6 +810,21 @@ resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
 `, rName, dnsSupport))
 }
 
+func testAccTransitGatewayVPCAttachmentConfig_securityGroupReferencingSupport(rName, securityGroupReferencingSupport string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayVPCAttachmentConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  security_group_referencing_support = %[2]q
+  subnet_ids                         = aws_subnet.test[*].id
+  transit_gateway_id                 = aws_ec2_transit_gateway.test.id
+  vpc_id                             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, securityGroupReferencingSupport))
+}
+
 func testAccTransitGatewayVPCAttachmentConfig_ipv6Support(rName, ipv6Support string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnetsIPv6(rName, 1), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -627,6 +846,49 @@ resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
 `, rName, ipv6Support))
 }
 
+func testAccTransitGatewayVPCAttachmentConfig_ipv6Only(rName, ipv6Support string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.1.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id                          = aws_vpc.test.id
+  ipv6_cidr_block                 = cidrsubnet(aws_vpc.test.ipv6_cidr_block, 8, 1)
+  assign_ipv6_address_on_creation = true
+  ipv6_native                     = true
+
+  enable_resource_name_dns_aaaa_record_on_launch = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  ipv6_support       = %[2]q
+  subnet_ids         = [aws_subnet.test.id]
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  vpc_id             = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, ipv6Support)
+}
+
 func testAccTransitGatewayVPCAttachmentConfig_sharedTransitGateway(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
 data "aws_organizations_organization" "test" {}
@@ -28,12 +28,28 @@ func DataSourceTransitGatewayVPNAttachment() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"enable_acceleration": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrFilter: customFiltersSchema(),
-			names.AttrTags:   tftags.TagsSchemaComputed(),
+			"static_routes_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
 			names.AttrTransitGatewayID: {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tunnel1_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel2_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"vpn_connection_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -81,9 +97,31 @@ func dataSourceTransitGatewayVPNAttachmentRead(ctx context.Context, d *schema.Re
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Transit Gateway VPN Attachment", err))
 	}
 
+	vpnConnectionID := aws.StringValue(transitGatewayAttachment.ResourceId)
+	vpnConnection, err := FindVPNConnectionByID(ctx, conn, vpnConnectionID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s): %s", vpnConnectionID, err)
+	}
+
 	d.SetId(aws.StringValue(transitGatewayAttachment.TransitGatewayAttachmentId))
+	if v := vpnConnection.Options; v != nil {
+		d.Set("enable_acceleration", v.EnableAcceleration)
+		d.Set("static_routes_only", v.StaticRoutesOnly)
+	} else {
+		d.Set("enable_acceleration", nil)
+		d.Set("static_routes_only", nil)
+	}
 	d.Set(names.AttrTransitGatewayID, transitGatewayAttachment.TransitGatewayId)
-	d.Set("vpn_connection_id", transitGatewayAttachment.ResourceId)
+	// Tunnels are ordered by outside address, as in aws_vpn_connection if no tunnel inside CIDR or pre-shared key is configured.
+	if tunnelInfo, err := CustomerGatewayConfigurationToTunnelInfo(aws.StringValue(vpnConnection.CustomerGatewayConfiguration), "", "", ""); err == nil {
+		d.Set("tunnel1_address", tunnelInfo.Tunnel1Address)
+		d.Set("tunnel2_address", tunnelInfo.Tunnel2Address)
+	} else {
+		d.Set("tunnel1_address", nil)
+		d.Set("tunnel2_address", nil)
+	}
+	d.Set("vpn_connection_id", vpnConnectionID)
 
 	if err := d.Set(names.AttrTags, KeyValueTags(ctx, transitGatewayAttachment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
@@ -35,8 +35,12 @@ func testAccTransitGatewayVPNAttachmentDataSource_idAndVPNConnectionID(t *testin
 			{
 				Config: testAccTransitGatewayVPNAttachmentDataSourceConfig_idAndVPNConnectionID(rName, rBgpAsn),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "enable_acceleration", vpnConnectionResourceName, "enable_acceleration"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "static_routes_only", vpnConnectionResourceName, "static_routes_only"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayID, transitGatewayResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tunnel1_address", vpnConnectionResourceName, "tunnel1_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tunnel2_address", vpnConnectionResourceName, "tunnel2_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "vpn_connection_id", vpnConnectionResourceName, names.AttrID),
 				),
 			},
@@ -65,8 +69,12 @@ func testAccTransitGatewayVPNAttachmentDataSource_filter(t *testing.T, semaphore
 			{
 				Config: testAccTransitGatewayVPNAttachmentDataSourceConfig_filter(rName, rBgpAsn),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "enable_acceleration", vpnConnectionResourceName, "enable_acceleration"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "static_routes_only", vpnConnectionResourceName, "static_routes_only"),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayID, transitGatewayResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tunnel1_address", vpnConnectionResourceName, "tunnel1_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tunnel2_address", vpnConnectionResourceName, "tunnel2_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "vpn_connection_id", vpnConnectionResourceName, names.AttrID),
 				),
 			},
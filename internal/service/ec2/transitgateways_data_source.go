@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_transit_gateways", name="Transit Gateways")
+func dataSourceTransitGateways() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewaysRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"transit_gateways": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amazon_side_asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"association_default_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auto_accept_shared_attachments": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_route_table_association": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_route_table_propagation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_support": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"multicast_support": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrOwnerID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"propagation_default_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_group_referencing_support": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTags: tftags.TagsSchemaComputed(),
+						"transit_gateway_cidr_blocks": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"vpn_ecmp_support": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeTransitGatewaysInput{}
+
+	input.Filters = append(input.Filters, newTagFilterList(
+		Tags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))),
+	)...)
+
+	input.Filters = append(input.Filters, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindTransitGateways(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateways: %s", err)
+	}
+
+	var transitGatewayIDs []string
+	var tfList []interface{}
+
+	for _, v := range output {
+		transitGatewayIDs = append(transitGatewayIDs, aws.StringValue(v.TransitGatewayId))
+		tfList = append(tfList, flattenTransitGateway(ctx, v, ignoreTagsConfig))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", transitGatewayIDs)
+	if err := d.Set("transit_gateways", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting transit_gateways: %s", err)
+	}
+
+	return diags
+}
+
+func flattenTransitGateway(ctx context.Context, apiObject *ec2.TransitGateway, ignoreTagsConfig *tftags.IgnoreConfig) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrARN:         aws.StringValue(apiObject.TransitGatewayArn),
+		names.AttrDescription: aws.StringValue(apiObject.Description),
+		names.AttrID:          aws.StringValue(apiObject.TransitGatewayId),
+		names.AttrOwnerID:     aws.StringValue(apiObject.OwnerId),
+		names.AttrState:       aws.StringValue(apiObject.State),
+		names.AttrTags:        KeyValueTags(ctx, apiObject.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+	}
+
+	if v := apiObject.Options; v != nil {
+		tfMap["amazon_side_asn"] = aws.Int64Value(v.AmazonSideAsn)
+		tfMap["association_default_route_table_id"] = aws.StringValue(v.AssociationDefaultRouteTableId)
+		tfMap["auto_accept_shared_attachments"] = aws.StringValue(v.AutoAcceptSharedAttachments)
+		tfMap["default_route_table_association"] = aws.StringValue(v.DefaultRouteTableAssociation)
+		tfMap["default_route_table_propagation"] = aws.StringValue(v.DefaultRouteTablePropagation)
+		tfMap["dns_support"] = aws.StringValue(v.DnsSupport)
+		tfMap["multicast_support"] = aws.StringValue(v.MulticastSupport)
+		tfMap["propagation_default_route_table_id"] = aws.StringValue(v.PropagationDefaultRouteTableId)
+		tfMap["security_group_referencing_support"] = aws.StringValue(v.SecurityGroupReferencingSupport)
+		tfMap["transit_gateway_cidr_blocks"] = aws.StringValueSlice(v.TransitGatewayCidrBlocks)
+		tfMap["vpn_ecmp_support"] = aws.StringValue(v.VpnEcmpSupport)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewaysDataSource_filter(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateways.test"
+	resourceName := "aws_ec2_transit_gateway.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewaysDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_gateways.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.amazon_side_asn", resourceName, "amazon_side_asn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.association_default_route_table_id", resourceName, "association_default_route_table_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.auto_accept_shared_attachments", resourceName, "auto_accept_shared_attachments"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.default_route_table_association", resourceName, "default_route_table_association"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.default_route_table_propagation", resourceName, "default_route_table_propagation"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.description", resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.dns_support", resourceName, "dns_support"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.multicast_support", resourceName, "multicast_support"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.owner_id", resourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.propagation_default_route_table_id", resourceName, "propagation_default_route_table_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_gateways.0.state", "available"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.transit_gateway_cidr_blocks.#", resourceName, "transit_gateway_cidr_blocks.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateways.0.vpn_ecmp_support", resourceName, "vpn_ecmp_support"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewaysDataSource_tags(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateways.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewaysDataSourceConfig_tags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ids.0", "aws_ec2_transit_gateway.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_gateways.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_gateways.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewaysDataSource_empty(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateways.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewaysDataSourceConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "transit_gateways.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewaysDataSourceConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateways" "test" {
+  filter {
+    name   = "transit-gateway-id"
+    values = [aws_ec2_transit_gateway.test.id]
+  }
+
+  filter {
+    name   = "state"
+    values = ["available"]
+  }
+}
+`, rName)
+}
+
+func testAccTransitGatewaysDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateways" "test" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_transit_gateway.test]
+}
+`, rName)
+}
+
+func testAccTransitGatewaysDataSourceConfig_empty(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_transit_gateways" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
@@ -458,6 +458,12 @@ func resourceVPCDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diags
 	}
 
+	if tfawserr_sdkv2.ErrCodeEquals(err, errCodeDependencyViolation) {
+		if dependencies, findErr := findVPCDependencies(ctx, conn, d.Id()); findErr == nil && len(dependencies) > 0 {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 VPC (%s): %s\n\n%s", d.Id(), err, formatVPCDependencies(dependencies))
+		}
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 VPC (%s): %s", d.Id(), err)
 	}
@@ -530,6 +536,90 @@ func resourceVPCCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v in
 	return nil
 }
 
+// findVPCDependencies returns descriptions of the resources in a VPC that prevent it from being deleted.
+// The VPC's default security group and main route table are deleted with the VPC and aren't included.
+func findVPCDependencies(ctx context.Context, conn *ec2.Client, vpcID string) ([]string, error) {
+	filters := newAttributeFilterListV2(map[string]string{
+		"vpc-id": vpcID,
+	})
+
+	subnets, err := findSubnetsV2(ctx, conn, &ec2.DescribeSubnetsInput{
+		Filters: filters,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Subnets: %w", err)
+	}
+
+	networkInterfaces, err := findNetworkInterfacesV2(ctx, conn, &ec2.DescribeNetworkInterfacesInput{
+		Filters: filters,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Network Interfaces: %w", err)
+	}
+
+	securityGroups, err := findSecurityGroupsV2(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+		Filters: filters,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Security Groups: %w", err)
+	}
+
+	routeTables, err := findRouteTablesV2(ctx, conn, &ec2.DescribeRouteTablesInput{
+		Filters: filters,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading EC2 Route Tables: %w", err)
+	}
+
+	return vpcDependencies(subnets, networkInterfaces, securityGroups, routeTables), nil
+}
+
+func vpcDependencies(subnets []types.Subnet, networkInterfaces []types.NetworkInterface, securityGroups []types.SecurityGroup, routeTables []types.RouteTable) []string {
+	var dependencies []string
+
+	for _, v := range subnets {
+		dependencies = append(dependencies, fmt.Sprintf("EC2 Subnet (%s): %s in %s", aws.ToString(v.SubnetId), aws.ToString(v.CidrBlock), aws.ToString(v.AvailabilityZone)))
+	}
+
+	for _, v := range networkInterfaces {
+		dependencies = append(dependencies, fmt.Sprintf("EC2 Network Interface (%s): %s %q", aws.ToString(v.NetworkInterfaceId), v.InterfaceType, aws.ToString(v.Description)))
+	}
+
+	for _, v := range securityGroups {
+		if aws.ToString(v.GroupName) == DefaultSecurityGroupName {
+			continue
+		}
+
+		dependencies = append(dependencies, fmt.Sprintf("EC2 Security Group (%s): %s", aws.ToString(v.GroupId), aws.ToString(v.GroupName)))
+	}
+
+	for _, v := range routeTables {
+		if slices.Any(v.Associations, func(v types.RouteTableAssociation) bool { return aws.ToBool(v.Main) }) {
+			continue
+		}
+
+		dependencies = append(dependencies, fmt.Sprintf("EC2 Route Table (%s)", aws.ToString(v.RouteTableId)))
+	}
+
+	return dependencies
+}
+
+func formatVPCDependencies(dependencies []string) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "The following %d resources must be deleted before the VPC can be deleted:", len(dependencies))
+	for _, v := range dependencies {
+		sb.WriteString("\n  - ")
+		sb.WriteString(v)
+	}
+
+	return sb.String()
+}
+
 // defaultIPv6CIDRBlockAssociation returns the "default" IPv6 CIDR block.
 // Try and find IPv6 CIDR block information, first by any stored association ID.
 // Then if no IPv6 CIDR block information is available, use the first associated IPv6 CIDR block.
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_vpc_availability_zone_capacity", name="VPC Availability Zone Capacity")
+func dataSourceVPCAvailabilityZoneCapacity() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCAvailabilityZoneCapacityRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAvailabilityZones: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"available_ip_address_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrSubnets: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"available_ip_address_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"cidr_block": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"supports_all_services": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"service_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCAvailabilityZoneCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	vpcID := d.Get(names.AttrVPCID).(string)
+	input := &ec2.DescribeSubnetsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"vpc-id": vpcID,
+		}),
+	}
+
+	subnets, err := FindSubnets(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets (%s): %s", vpcID, err)
+	}
+
+	// Availability Zone name -> requested service names offered in that zone.
+	serviceNamesByAZ := make(map[string][]string)
+	var serviceNames []string
+
+	if v, ok := d.GetOk("service_names"); ok && v.(*schema.Set).Len() > 0 {
+		serviceNames = flex.ExpandStringValueSet(v.(*schema.Set))
+		slices.Sort(serviceNames)
+
+		input := &ec2.DescribeVpcEndpointServicesInput{
+			ServiceNames: aws.StringSlice(serviceNames),
+		}
+
+		serviceDetails, _, err := FindVPCEndpointServices(ctx, conn, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 VPC Endpoint Services: %s", err)
+		}
+
+		for _, v := range serviceDetails {
+			serviceName := aws.StringValue(v.ServiceName)
+
+			for _, az := range aws.StringValueSlice(v.AvailabilityZones) {
+				if !slices.Contains(serviceNamesByAZ[az], serviceName) {
+					serviceNamesByAZ[az] = append(serviceNamesByAZ[az], serviceName)
+				}
+			}
+		}
+	}
+
+	d.SetId(vpcID)
+	if err := d.Set(names.AttrAvailabilityZones, flattenVPCAvailabilityZoneCapacities(subnets, serviceNames, serviceNamesByAZ)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting availability_zones: %s", err)
+	}
+
+	return diags
+}
+
+func flattenVPCAvailabilityZoneCapacities(subnets []*ec2.Subnet, serviceNames []string, serviceNamesByAZ map[string][]string) []interface{} {
+	var azNames []string
+	zoneIDs := make(map[string]string)
+	subnetsByAZ := make(map[string][]*ec2.Subnet)
+
+	for _, v := range subnets {
+		az := aws.StringValue(v.AvailabilityZone)
+
+		if _, ok := subnetsByAZ[az]; !ok {
+			azNames = append(azNames, az)
+			zoneIDs[az] = aws.StringValue(v.AvailabilityZoneId)
+		}
+
+		subnetsByAZ[az] = append(subnetsByAZ[az], v)
+	}
+
+	slices.Sort(azNames)
+
+	tfList := make([]interface{}, 0, len(azNames))
+
+	for _, az := range azNames {
+		subnets := subnetsByAZ[az]
+		slices.SortFunc(subnets, func(a, b *ec2.Subnet) int {
+			return strings.Compare(aws.StringValue(a.SubnetId), aws.StringValue(b.SubnetId))
+		})
+
+		var availableIPAddressCount int64
+		tfListSubnets := make([]interface{}, 0, len(subnets))
+
+		for _, v := range subnets {
+			availableIPAddressCount += aws.Int64Value(v.AvailableIpAddressCount)
+
+			tfListSubnets = append(tfListSubnets, map[string]interface{}{
+				"available_ip_address_count": aws.Int64Value(v.AvailableIpAddressCount),
+				"cidr_block":                 aws.StringValue(v.CidrBlock),
+				names.AttrID:                 aws.StringValue(v.SubnetId),
+			})
+		}
+
+		azServiceNames := serviceNamesByAZ[az]
+
+		tfList = append(tfList, map[string]interface{}{
+			"available_ip_address_count": availableIPAddressCount,
+			names.AttrName:               az,
+			"service_names":              flex.FlattenStringValueSet(azServiceNames),
+			names.AttrSubnets:            tfListSubnets,
+			"supports_all_services":      len(azServiceNames) == len(serviceNames),
+			"zone_id":                    zoneIDs[az],
+		})
+	}
+
+	return tfList
+}
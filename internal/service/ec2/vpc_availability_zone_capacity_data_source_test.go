@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCAvailabilityZoneCapacityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_availability_zone_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCAvailabilityZoneCapacityDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, "aws_vpc.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zones.0.name", "aws_subnet.test.0", names.AttrAvailabilityZone),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zones.0.zone_id", "aws_subnet.test.0", "availability_zone_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.available_ip_address_count", "251"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.service_names.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.subnets.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zones.0.subnets.0.id", "aws_subnet.test.0", names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zones.0.subnets.0.cidr_block", "aws_subnet.test.0", "cidr_block"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.subnets.0.available_ip_address_count", "251"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.supports_all_services", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCAvailabilityZoneCapacityDataSource_serviceNames(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_availability_zone_capacity.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCAvailabilityZoneCapacityDataSourceConfig_serviceNames(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.service_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "availability_zones.0.service_names.*", "data.aws_vpc_endpoint_service.test", names.AttrServiceName),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.0.supports_all_services", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.1.service_names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "availability_zones.1.supports_all_services", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCAvailabilityZoneCapacityDataSourceConfig_base(rName string) string {
+	return acctest.ConfigVPCWithSubnets(rName, 2)
+}
+
+func testAccVPCAvailabilityZoneCapacityDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCAvailabilityZoneCapacityDataSourceConfig_base(rName), `
+data "aws_vpc_availability_zone_capacity" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_subnet.test]
+}
+`)
+}
+
+func testAccVPCAvailabilityZoneCapacityDataSourceConfig_serviceNames(rName string) string {
+	return acctest.ConfigCompose(testAccVPCAvailabilityZoneCapacityDataSourceConfig_base(rName), `
+data "aws_vpc_endpoint_service" "test" {
+  service      = "s3"
+  service_type = "Interface"
+}
+
+data "aws_vpc_availability_zone_capacity" "test" {
+  vpc_id        = aws_vpc.test.id
+  service_names = [data.aws_vpc_endpoint_service.test.service_name]
+
+  depends_on = [aws_subnet.test]
+}
+`)
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -165,6 +166,43 @@ func TestAccVPCDHCPOptions_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVPCDHCPOptions_createBeforeDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var d1, d2 ec2.DhcpOptions
+	resourceName := "aws_vpc_dhcp_options.test"
+	vpcResourceName := "aws_vpc.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDHCPOptionsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCDHCPOptionsConfig_associated(rName, "10.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDHCPOptionsExists(ctx, resourceName, &d1),
+					testAccCheckDHCPOptionsAssociatedWithVPC(ctx, resourceName, vpcResourceName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_servers.0", "10.0.0.2"),
+				),
+			},
+			{
+				Config: testAccVPCDHCPOptionsConfig_associated(rName, "10.0.0.3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDHCPOptionsExists(ctx, resourceName, &d2),
+					testAccCheckDHCPOptionsRecreated(&d1, &d2),
+					testAccCheckDHCPOptionsAssociatedWithVPC(ctx, resourceName, vpcResourceName),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_servers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "domain_name_servers.0", "10.0.0.3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDHCPOptionsDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
@@ -216,6 +254,34 @@ func testAccCheckDHCPOptionsExists(ctx context.Context, n string, v *ec2.DhcpOpt
 	}
 }
 
+func testAccCheckDHCPOptionsAssociatedWithVPC(ctx context.Context, dhcpOptionsResourceName, vpcResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dhcpOptions, ok := s.RootModule().Resources[dhcpOptionsResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", dhcpOptionsResourceName)
+		}
+
+		vpc, ok := s.RootModule().Resources[vpcResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", vpcResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		return tfec2.FindVPCDHCPOptionsAssociation(ctx, conn, vpc.Primary.ID, dhcpOptions.Primary.ID)
+	}
+}
+
+func testAccCheckDHCPOptionsRecreated(before, after *ec2.DhcpOptions) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.DhcpOptionsId) == aws.StringValue(after.DhcpOptionsId) {
+			return fmt.Errorf("EC2 DHCP Options Set (%s) not recreated", aws.StringValue(before.DhcpOptionsId))
+		}
+
+		return nil
+	}
+}
+
 const testAccVPCDHCPOptionsConfig_basic = `
 resource "aws_vpc_dhcp_options" "test" {
   netbios_node_type = 1
@@ -263,3 +329,32 @@ resource "aws_vpc_dhcp_options" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccVPCDHCPOptionsConfig_associated(rName, domainNameServer string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_dhcp_options" "test" {
+  domain_name_servers = [%[2]q]
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_vpc_dhcp_options_association" "test" {
+  vpc_id          = aws_vpc.test.id
+  dhcp_options_id = aws_vpc_dhcp_options.test.id
+}
+`, rName, domainNameServer)
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_security_group_rules_exporter")
+func DataSourceSecurityGroupRulesExporter() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecurityGroupRulesExporterRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: customFiltersSchema(),
+			"include_ids": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrSecurityGroupIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecurityGroupRulesExporterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	vpcID := d.Get(names.AttrVPCID).(string)
+	input := &ec2.DescribeSecurityGroupsInput{
+		Filters: newAttributeFilterList(map[string]string{
+			"vpc-id": vpcID,
+		}),
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterList(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	securityGroups, err := FindSecurityGroups(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Security Groups (%s): %s", vpcID, err)
+	}
+
+	var securityGroupIDs []string
+	rules := make(map[string][]*ec2.SecurityGroupRule)
+
+	for _, v := range securityGroups {
+		securityGroupID := aws.StringValue(v.GroupId)
+		securityGroupIDs = append(securityGroupIDs, securityGroupID)
+
+		output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Security Group (%s) Rules: %s", securityGroupID, err)
+		}
+
+		rules[securityGroupID] = output
+	}
+
+	// Resolve the names of referenced security groups outside the exported security groups,
+	// e.g. in a peered VPC. Security groups in other accounts can't be resolved and are referenced by ID only.
+	groupNames := make(map[string]string)
+	for _, v := range securityGroups {
+		groupNames[aws.StringValue(v.GroupId)] = aws.StringValue(v.GroupName)
+	}
+
+	var unresolvedIDs []string
+	for _, output := range rules {
+		for _, v := range output {
+			if v.ReferencedGroupInfo == nil {
+				continue
+			}
+
+			if id := aws.StringValue(v.ReferencedGroupInfo.GroupId); id != "" {
+				if _, ok := groupNames[id]; !ok {
+					groupNames[id] = ""
+					unresolvedIDs = append(unresolvedIDs, id)
+				}
+			}
+		}
+	}
+
+	if len(unresolvedIDs) > 0 {
+		output, err := FindSecurityGroups(ctx, conn, &ec2.DescribeSecurityGroupsInput{
+			Filters: []*ec2.Filter{{
+				Name:   aws.String("group-id"),
+				Values: aws.StringSlice(unresolvedIDs),
+			}},
+		})
+
+		if err != nil && !tfresource.NotFound(err) {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Security Groups: %s", err)
+		}
+
+		for _, v := range output {
+			groupNames[aws.StringValue(v.GroupId)] = aws.StringValue(v.GroupName)
+		}
+	}
+
+	document := newSecurityGroupRulesDocument(securityGroups, rules, groupNames, d.Get("include_ids").(bool))
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "encoding EC2 Security Group Rules (%s): %s", vpcID, err)
+	}
+
+	d.SetId(vpcID)
+	d.Set("json", string(b))
+	d.Set(names.AttrSecurityGroupIDs, securityGroupIDs)
+
+	return diags
+}
+
+// securityGroupRulesDocument is the normalized representation of the rules of a set of security groups.
+// Security groups and rules are sorted so that documents for equivalent environments are identical.
+type securityGroupRulesDocument struct {
+	SecurityGroups []*securityGroupRulesDocumentGroup `json:"security_groups"`
+}
+
+type securityGroupRulesDocumentGroup struct {
+	Description string                            `json:"description,omitempty"`
+	Egress      []*securityGroupRulesDocumentRule `json:"egress"`
+	ID          string                            `json:"id,omitempty"`
+	Ingress     []*securityGroupRulesDocumentRule `json:"ingress"`
+	Name        string                            `json:"name"`
+}
+
+type securityGroupRulesDocumentRule struct {
+	CIDRIPv4                string                                     `json:"cidr_ipv4,omitempty"`
+	CIDRIPv6                string                                     `json:"cidr_ipv6,omitempty"`
+	Description             string                                     `json:"description,omitempty"`
+	FromPort                *int64                                     `json:"from_port,omitempty"`
+	PrefixListID            string                                     `json:"prefix_list_id,omitempty"`
+	Protocol                string                                     `json:"protocol"`
+	ReferencedSecurityGroup *securityGroupRulesDocumentReferencedGroup `json:"referenced_security_group,omitempty"`
+	ToPort                  *int64                                     `json:"to_port,omitempty"`
+}
+
+type securityGroupRulesDocumentReferencedGroup struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	OwnerID string `json:"owner_id,omitempty"`
+	VPCID   string `json:"vpc_id,omitempty"`
+}
+
+// newSecurityGroupRulesDocument returns the normalized document for the specified security groups and their rules.
+// groupNames maps security group IDs to names and is used to reference security groups by name.
+// Unless includeIDs is set, security group and referenced security group IDs are omitted, except for referenced
+// security groups whose names are unknown. A referenced security group's owner and VPC are only included if they differ
+// from those of the referencing security group.
+func newSecurityGroupRulesDocument(securityGroups []*ec2.SecurityGroup, rules map[string][]*ec2.SecurityGroupRule, groupNames map[string]string, includeIDs bool) *securityGroupRulesDocument {
+	document := &securityGroupRulesDocument{
+		SecurityGroups: make([]*securityGroupRulesDocumentGroup, 0, len(securityGroups)),
+	}
+
+	for _, sg := range securityGroups {
+		group := &securityGroupRulesDocumentGroup{
+			Description: aws.StringValue(sg.Description),
+			Egress:      []*securityGroupRulesDocumentRule{},
+			Ingress:     []*securityGroupRulesDocumentRule{},
+			Name:        aws.StringValue(sg.GroupName),
+		}
+
+		if includeIDs {
+			group.ID = aws.StringValue(sg.GroupId)
+		}
+
+		for _, v := range rules[aws.StringValue(sg.GroupId)] {
+			rule := &securityGroupRulesDocumentRule{
+				CIDRIPv4:     aws.StringValue(v.CidrIpv4),
+				CIDRIPv6:     aws.StringValue(v.CidrIpv6),
+				Description:  aws.StringValue(v.Description),
+				PrefixListID: aws.StringValue(v.PrefixListId),
+				Protocol:     protocolForValue(aws.StringValue(v.IpProtocol)),
+			}
+
+			if rule.Protocol != "-1" {
+				rule.FromPort = v.FromPort
+				rule.ToPort = v.ToPort
+			}
+
+			if v := v.ReferencedGroupInfo; v != nil {
+				id := aws.StringValue(v.GroupId)
+				referencedGroup := &securityGroupRulesDocumentReferencedGroup{
+					Name: groupNames[id],
+				}
+
+				if includeIDs || referencedGroup.Name == "" {
+					referencedGroup.ID = id
+				}
+
+				if ownerID := aws.StringValue(v.UserId); ownerID != aws.StringValue(sg.OwnerId) {
+					referencedGroup.OwnerID = ownerID
+				}
+
+				if vpcID := aws.StringValue(v.VpcId); vpcID != "" && vpcID != aws.StringValue(sg.VpcId) {
+					referencedGroup.VPCID = vpcID
+				}
+
+				rule.ReferencedSecurityGroup = referencedGroup
+			}
+
+			if aws.BoolValue(v.IsEgress) {
+				group.Egress = append(group.Egress, rule)
+			} else {
+				group.Ingress = append(group.Ingress, rule)
+			}
+		}
+
+		sortSecurityGroupRulesDocumentRules(group.Egress)
+		sortSecurityGroupRulesDocumentRules(group.Ingress)
+
+		document.SecurityGroups = append(document.SecurityGroups, group)
+	}
+
+	sort.SliceStable(document.SecurityGroups, func(i, j int) bool {
+		if a, b := document.SecurityGroups[i], document.SecurityGroups[j]; a.Name != b.Name {
+			return a.Name < b.Name
+		} else {
+			return a.ID < b.ID
+		}
+	})
+
+	return document
+}
+
+// sortSecurityGroupRulesDocumentRules sorts rules by their JSON encoding.
+func sortSecurityGroupRulesDocumentRules(rules []*securityGroupRulesDocumentRule) {
+	keys := make(map[*securityGroupRulesDocumentRule]string, len(rules))
+	for _, v := range rules {
+		b, _ := json.Marshal(v)
+		keys[v] = string(b)
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return keys[rules[i]] < keys[rules[j]]
+	})
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSecurityGroupRulesDocument(t *testing.T) {
+	t.Parallel()
+
+	securityGroups := []*ec2.SecurityGroup{
+		{
+			Description: aws.String("web"),
+			GroupId:     aws.String("sg-22222222"),
+			GroupName:   aws.String("web"),
+			OwnerId:     aws.String("111111111111"),
+			VpcId:       aws.String("vpc-11111111"),
+		},
+		{
+			Description: aws.String("db"),
+			GroupId:     aws.String("sg-11111111"),
+			GroupName:   aws.String("db"),
+			OwnerId:     aws.String("111111111111"),
+			VpcId:       aws.String("vpc-11111111"),
+		},
+	}
+	rules := map[string][]*ec2.SecurityGroupRule{
+		"sg-22222222": {
+			{
+				CidrIpv4:   aws.String("0.0.0.0/0"),
+				FromPort:   aws.Int64(-1),
+				IpProtocol: aws.String("-1"),
+				IsEgress:   aws.Bool(true),
+				ToPort:     aws.Int64(-1),
+			},
+			{
+				CidrIpv4:   aws.String("0.0.0.0/0"),
+				FromPort:   aws.Int64(443),
+				IpProtocol: aws.String("tcp"),
+				IsEgress:   aws.Bool(false),
+				ToPort:     aws.Int64(443),
+			},
+			{
+				CidrIpv6:   aws.String("::/0"),
+				FromPort:   aws.Int64(443),
+				IpProtocol: aws.String("6"),
+				IsEgress:   aws.Bool(false),
+				ToPort:     aws.Int64(443),
+			},
+		},
+		"sg-11111111": {
+			{
+				Description: aws.String("from web"),
+				FromPort:    aws.Int64(5432),
+				IpProtocol:  aws.String("tcp"),
+				IsEgress:    aws.Bool(false),
+				ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{
+					GroupId: aws.String("sg-22222222"),
+					UserId:  aws.String("111111111111"),
+				},
+				ToPort: aws.Int64(5432),
+			},
+			{
+				FromPort:   aws.Int64(5432),
+				IpProtocol: aws.String("tcp"),
+				IsEgress:   aws.Bool(false),
+				ReferencedGroupInfo: &ec2.ReferencedSecurityGroup{
+					GroupId: aws.String("sg-33333333"),
+					UserId:  aws.String("222222222222"),
+					VpcId:   aws.String("vpc-22222222"),
+				},
+				ToPort: aws.Int64(5432),
+			},
+		},
+	}
+	groupNames := map[string]string{
+		"sg-11111111": "db",
+		"sg-22222222": "web",
+		"sg-33333333": "",
+	}
+
+	testCases := map[string]struct {
+		includeIDs bool
+		expected   string
+	}{
+		"without IDs": {
+			expected: `{"security_groups":[` +
+				`{"description":"db","egress":[],"ingress":[` +
+				`{"description":"from web","from_port":5432,"protocol":"tcp","referenced_security_group":{"name":"web"},"to_port":5432},` +
+				`{"from_port":5432,"protocol":"tcp","referenced_security_group":{"id":"sg-33333333","owner_id":"222222222222","vpc_id":"vpc-22222222"},"to_port":5432}` +
+				`],"name":"db"},` +
+				`{"description":"web","egress":[{"cidr_ipv4":"0.0.0.0/0","protocol":"-1"}],"ingress":[` +
+				`{"cidr_ipv4":"0.0.0.0/0","from_port":443,"protocol":"tcp","to_port":443},` +
+				`{"cidr_ipv6":"::/0","from_port":443,"protocol":"tcp","to_port":443}` +
+				`],"name":"web"}]}`,
+		},
+		"with IDs": {
+			includeIDs: true,
+			expected: `{"security_groups":[` +
+				`{"description":"db","egress":[],"id":"sg-11111111","ingress":[` +
+				`{"description":"from web","from_port":5432,"protocol":"tcp","referenced_security_group":{"id":"sg-22222222","name":"web"},"to_port":5432},` +
+				`{"from_port":5432,"protocol":"tcp","referenced_security_group":{"id":"sg-33333333","owner_id":"222222222222","vpc_id":"vpc-22222222"},"to_port":5432}` +
+				`],"name":"db"},` +
+				`{"description":"web","egress":[{"cidr_ipv4":"0.0.0.0/0","protocol":"-1"}],"id":"sg-22222222","ingress":[` +
+				`{"cidr_ipv4":"0.0.0.0/0","from_port":443,"protocol":"tcp","to_port":443},` +
+				`{"cidr_ipv6":"::/0","from_port":443,"protocol":"tcp","to_port":443}` +
+				`],"name":"web"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := json.Marshal(tfec2.NewSecurityGroupRulesDocument(securityGroups, rules, groupNames, testCase.includeIDs))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := string(b), testCase.expected; got != want {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestAccVPCSecurityGroupRulesExporterDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_security_group_rules_exporter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesExporterDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "security_group_ids.#", "3"),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexache.MustCompile(fmt.Sprintf(`"referenced_security_group":\{"name":"%s-web"\}`, rName))),
+					resource.TestMatchResourceAttr(dataSourceName, "json", regexache.MustCompile(`"cidr_ipv4":"0.0.0.0/0","from_port":443,"protocol":"tcp","to_port":443`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCSecurityGroupRulesExporterDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "web" {
+  name   = "%[1]s-web"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_security_group" "db" {
+  name   = "%[1]s-db"
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_ingress_rule" "web" {
+  security_group_id = aws_security_group.web.id
+
+  cidr_ipv4   = "0.0.0.0/0"
+  from_port   = 443
+  ip_protocol = "tcp"
+  to_port     = 443
+}
+
+resource "aws_vpc_security_group_ingress_rule" "db" {
+  security_group_id = aws_security_group.db.id
+
+  referenced_security_group_id = aws_security_group.web.id
+  from_port                    = 5432
+  ip_protocol                  = "tcp"
+  to_port                      = 5432
+}
+
+data "aws_security_group_rules_exporter" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_vpc_security_group_ingress_rule.web, aws_vpc_security_group_ingress_rule.db]
+}
+`, rName)
+}
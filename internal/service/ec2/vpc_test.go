@@ -12,6 +12,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -22,6 +23,37 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestVPCDependencies(t *testing.T) {
+	t.Parallel()
+
+	subnets := []awstypes.Subnet{
+		{SubnetId: aws.String("subnet-1"), CidrBlock: aws.String("10.1.0.0/24"), AvailabilityZone: aws.String("us-west-2a")}, //lintignore:AWSAT003
+	}
+	networkInterfaces := []awstypes.NetworkInterface{
+		{NetworkInterfaceId: aws.String("eni-1"), InterfaceType: awstypes.NetworkInterfaceTypeNatGateway, Description: aws.String("Interface for NAT Gateway nat-1")},
+	}
+	securityGroups := []awstypes.SecurityGroup{
+		{GroupId: aws.String("sg-1"), GroupName: aws.String("default")},
+		{GroupId: aws.String("sg-2"), GroupName: aws.String("web")},
+	}
+	routeTables := []awstypes.RouteTable{
+		{RouteTableId: aws.String("rtb-1"), Associations: []awstypes.RouteTableAssociation{{Main: aws.Bool(true)}}},
+		{RouteTableId: aws.String("rtb-2"), Associations: []awstypes.RouteTableAssociation{{Main: aws.Bool(false), SubnetId: aws.String("subnet-1")}}},
+	}
+
+	got := tfec2.VPCDependencies(subnets, networkInterfaces, securityGroups, routeTables)
+	want := []string{
+		"EC2 Subnet (subnet-1): 10.1.0.0/24 in us-west-2a", //lintignore:AWSAT003
+		`EC2 Network Interface (eni-1): natGateway "Interface for NAT Gateway nat-1"`,
+		"EC2 Security Group (sg-2): web",
+		"EC2 Route Table (rtb-2)",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
 func TestAccVPC_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var vpc awstypes.Vpc
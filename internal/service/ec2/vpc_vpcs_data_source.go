@@ -5,14 +5,17 @@ package ec2
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,7 +36,59 @@ func DataSourceVPCs() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"include_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"not_tag_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tag_keys": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
+			"vpcs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrOwnerID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTags: tftags.TagsSchemaComputed(),
+					},
+				},
+			},
 		},
 	}
 }
@@ -41,6 +96,7 @@ func DataSourceVPCs() *schema.Resource {
 func dataSourceVPCsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	input := &ec2.DescribeVpcsInput{}
 
@@ -50,6 +106,13 @@ func dataSourceVPCsRead(ctx context.Context, d *schema.ResourceData, meta interf
 		)...)
 	}
 
+	// Values of a single filter are ORed, so each tag key that must be present needs its own filter.
+	for _, v := range flex.ExpandStringValueSet(d.Get("tag_keys").(*schema.Set)) {
+		input.Filters = append(input.Filters, newAttributeFilterList(map[string]string{
+			"tag-key": v,
+		})...)
+	}
+
 	if filters, filtersOk := d.GetOk(names.AttrFilter); filtersOk {
 		input.Filters = append(input.Filters,
 			newCustomFilterList(filters.(*schema.Set))...)
@@ -65,14 +128,51 @@ func dataSourceVPCsRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading EC2 VPCs: %s", err)
 	}
 
+	// There's no filter for missing tag keys.
+	notTagKeys := flex.ExpandStringValueSet(d.Get("not_tag_keys").(*schema.Set))
+	includeDetails := d.Get("include_details").(bool)
 	var vpcIDs []string
+	var vpcs []interface{}
 
 	for _, v := range output {
+		tags := KeyValueTags(ctx, v.Tags)
+
+		if slices.ContainsFunc(notTagKeys, tags.KeyExists) {
+			continue
+		}
+
 		vpcIDs = append(vpcIDs, aws.StringValue(v.VpcId))
+
+		if includeDetails {
+			tfMap := map[string]interface{}{
+				names.AttrARN: arn.ARN{
+					Partition: meta.(*conns.AWSClient).Partition,
+					Service:   names.EC2,
+					Region:    meta.(*conns.AWSClient).Region,
+					AccountID: aws.StringValue(v.OwnerId),
+					Resource:  "vpc/" + aws.StringValue(v.VpcId),
+				}.String(),
+				"cidr_block":      aws.StringValue(v.CidrBlock),
+				"default":         aws.BoolValue(v.IsDefault),
+				names.AttrID:      aws.StringValue(v.VpcId),
+				names.AttrOwnerID: aws.StringValue(v.OwnerId),
+				names.AttrTags:    tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+			}
+
+			if len(v.Ipv6CidrBlockAssociationSet) > 0 {
+				tfMap["ipv6_association_id"] = aws.StringValue(v.Ipv6CidrBlockAssociationSet[0].AssociationId)
+				tfMap["ipv6_cidr_block"] = aws.StringValue(v.Ipv6CidrBlockAssociationSet[0].Ipv6CidrBlock)
+			}
+
+			vpcs = append(vpcs, tfMap)
+		}
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set("ids", vpcIDs)
+	if err := d.Set("vpcs", vpcs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting vpcs: %s", err)
+	}
 
 	return diags
 }
@@ -45,6 +45,7 @@ func TestAccVPCsDataSource_tags(t *testing.T) {
 				Config: testAccVPCVPCsDataSourceConfig_tags(rName),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.aws_vpcs.test", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.test", "vpcs.#", "0"),
 				),
 			},
 		},
@@ -70,6 +71,27 @@ func TestAccVPCsDataSource_filters(t *testing.T) {
 	})
 }
 
+func TestAccVPCsDataSource_tagKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCVPCsDataSourceConfig_tagKeys(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpcs.tagged", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_vpcs.not_tagged", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_vpcs.not_tagged", "ids.0", "aws_vpc.test2", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCsDataSource_empty(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -89,6 +111,37 @@ func TestAccVPCsDataSource_empty(t *testing.T) {
 	})
 }
 
+func TestAccVPCsDataSource_includeDetails(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpcs.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCVPCsDataSourceConfig_includeDetails(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.arn", vpcResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.cidr_block", vpcResourceName, "cidr_block"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.0.default", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.id", vpcResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.ipv6_association_id", vpcResourceName, "ipv6_association_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.ipv6_cidr_block", vpcResourceName, "ipv6_cidr_block"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpcs.0.owner_id", vpcResourceName, names.AttrOwnerID),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vpcs.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCVPCsDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -142,6 +195,42 @@ data "aws_vpcs" "test" {
 `, rName)
 }
 
+func testAccVPCVPCsDataSourceConfig_tagKeys(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test1" {
+  cidr_block = "10.0.0.0/24"
+
+  tags = {
+    Name       = %[1]q
+    %[1]s      = "test1"
+    CostCenter = "test1"
+  }
+}
+
+resource "aws_vpc" "test2" {
+  cidr_block = "10.0.1.0/24"
+
+  tags = {
+    Name  = %[1]q
+    %[1]s = "test2"
+  }
+}
+
+data "aws_vpcs" "tagged" {
+  tag_keys = [%[1]q]
+
+  depends_on = [aws_vpc.test1, aws_vpc.test2]
+}
+
+data "aws_vpcs" "not_tagged" {
+  tag_keys     = [%[1]q]
+  not_tag_keys = ["CostCenter"]
+
+  depends_on = [aws_vpc.test1, aws_vpc.test2]
+}
+`, rName)
+}
+
 func testAccVPCVPCsDataSourceConfig_empty(rName string) string {
 	return fmt.Sprintf(`
 data "aws_vpcs" "test" {
@@ -151,3 +240,24 @@ data "aws_vpcs" "test" {
 }
 `, rName)
 }
+
+func testAccVPCVPCsDataSourceConfig_includeDetails(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.0.0.0/24"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpcs" "test" {
+  include_details = true
+
+  tags = {
+    Name = aws_vpc.test.tags["Name"]
+  }
+}
+`, rName)
+}
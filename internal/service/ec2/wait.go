@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"time"
 
@@ -1232,6 +1233,8 @@ func WaitTransitGatewayPrefixListReferenceStateUpdated(ctx context.Context, conn
 const (
 	TransitGatewayRouteCreatedTimeout = 2 * time.Minute
 	TransitGatewayRouteDeletedTimeout = 2 * time.Minute
+
+	transitGatewayRouteNotFoundChecks = 1000 // Should exceed any reasonable custom timeout value.
 )
 
 func WaitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, destination string) (*ec2.TransitGatewayRoute, error) {
@@ -1251,6 +1254,63 @@ func WaitTransitGatewayRouteCreated(ctx context.Context, conn *ec2.EC2, transitG
 	return nil, err
 }
 
+// waitTransitGatewayStaticRouteActive waits for a static route to become active, failing if it becomes a blackhole route.
+func waitTransitGatewayStaticRouteActive(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, destination string, timeout time.Duration) (*ec2.TransitGatewayRoute, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.TransitGatewayRouteStatePending},
+		Target:  []string{ec2.TransitGatewayRouteStateActive},
+		Timeout: timeout,
+		Refresh: StatusTransitGatewayStaticRouteState(ctx, conn, transitGatewayRouteTableID, destination),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitTransitGatewayRouteActive waits for a route of any type for the destination to appear in the route table and become active.
+func waitTransitGatewayRouteActive(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, destination string, timeout time.Duration) (*ec2.TransitGatewayRoute, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{ec2.TransitGatewayRouteStatePending},
+		Target:         []string{ec2.TransitGatewayRouteStateActive},
+		Timeout:        timeout,
+		Refresh:        statusTransitGatewayRouteState(ctx, conn, transitGatewayRouteTableID, destination),
+		NotFoundChecks: transitGatewayRouteNotFoundChecks,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func WaitTransitGatewayRouteReplaced(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, destination, state string) (*ec2.TransitGatewayRoute, error) {
+	pending := []string{ec2.TransitGatewayRouteStatePending, ec2.TransitGatewayRouteStateActive, ec2.TransitGatewayRouteStateBlackhole}
+	pending = slices.DeleteFunc(pending, func(v string) bool { return v == state })
+
+	stateConf := &retry.StateChangeConf{
+		Pending: pending,
+		Target:  []string{state},
+		Timeout: TransitGatewayRouteCreatedTimeout,
+		Refresh: StatusTransitGatewayStaticRouteState(ctx, conn, transitGatewayRouteTableID, destination),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.TransitGatewayRoute); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.EC2, transitGatewayRouteTableID, destination string) (*ec2.TransitGatewayRoute, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayRouteStateActive, ec2.TransitGatewayRouteStateBlackhole, ec2.TransitGatewayRouteStateDeleting},
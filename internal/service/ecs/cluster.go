@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -347,6 +349,50 @@ func FindClusterByNameOrARN(ctx context.Context, conn *ecs.ECS, nameOrARN string
 	return output.Clusters[0], nil
 }
 
+// findClusterStatisticsByNameOrARN returns the cluster's statistics, which are separated by launch type, keyed by name.
+func findClusterStatisticsByNameOrARN(ctx context.Context, conn *ecs.ECS, nameOrARN string) (map[string]int, error) {
+	input := &ecs.DescribeClustersInput{
+		Clusters: aws.StringSlice([]string{nameOrARN}),
+		Include:  aws.StringSlice([]string{ecs.ClusterFieldStatistics}),
+	}
+
+	output, err := conn.DescribeClustersWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	statistics := make(map[string]int)
+
+	for _, v := range output.Clusters[0].Statistics {
+		if v == nil {
+			continue
+		}
+
+		n, err := strconv.Atoi(aws.StringValue(v.Value))
+
+		if err != nil {
+			continue
+		}
+
+		// The case of statistic names isn't consistent.
+		statistics[strings.ToLower(aws.StringValue(v.Name))] = n
+	}
+
+	return statistics, nil
+}
+
 func statusCluster(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
@@ -381,6 +427,40 @@ func waitClusterAvailable(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.
 	return nil, err
 }
 
+// statusClusterAttachments returns NotFound errors, as the empty status of a cluster without attachments is a target of the waiter.
+func statusClusterAttachments(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := FindClusterByNameOrARN(ctx, conn, arn)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return cluster, aws.StringValue(cluster.AttachmentsStatus), err
+	}
+}
+
+// waitClusterAttachmentsUpdated waits for the asynchronous update of a cluster's attachments, e.g. the capacity providers' Auto Scaling
+// resources, to complete so that services using the capacity providers can be created.
+// A cluster without attachments has no attachments status.
+func waitClusterAttachmentsUpdated(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.Cluster, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{clusterAttachmentsStatusUpdateInProgress},
+		Target:  []string{clusterAttachmentsStatusUpdateComplete, ""},
+		Refresh: statusClusterAttachments(ctx, conn, arn),
+		Timeout: clusterUpdateTimeout,
+		Delay:   clusterAvailableDelay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*ecs.Cluster); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
 func waitClusterDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.Cluster, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{clusterStatusActive, clusterStatusDeprovisioning},
@@ -103,6 +103,10 @@ func resourceClusterCapacityProvidersPut(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Cluster Capacity Providers (%s) update: %s", d.Id(), err)
 	}
 
+	if _, err := waitClusterAttachmentsUpdated(ctx, conn, clusterName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Cluster Capacity Providers (%s) attachments update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourceClusterCapacityProvidersRead(ctx, d, meta)...)
 }
 
@@ -160,6 +164,11 @@ func resourceClusterCapacityProvidersDelete(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Cluster Capacity Providers (%s) delete: %s", d.Id(), err)
 	}
 
+	// A cluster deleted in the meantime has no capacity providers.
+	if _, err := waitClusterAttachmentsUpdated(ctx, conn, d.Id()); err != nil && !tfresource.NotFound(err) {
+		return sdkdiag.AppendErrorf(diags, "waiting for ECS Cluster Capacity Providers (%s) attachments update: %s", d.Id(), err)
+	}
+
 	return diags
 }
 
@@ -21,6 +21,18 @@ func DataSourceCluster() *schema.Resource {
 		ReadWithoutTimeout: dataSourceClusterRead,
 
 		Schema: map[string]*schema.Schema{
+			"active_ec2_services_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"active_fargate_services_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"active_services_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -29,6 +41,14 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"pending_ec2_tasks_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pending_fargate_tasks_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"pending_tasks_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -37,6 +57,14 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"running_ec2_tasks_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"running_fargate_tasks_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"running_tasks_count": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -91,9 +119,22 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading ECS Cluster (%s): %s", clusterName, err)
 	}
 
+	statistics, err := findClusterStatisticsByNameOrARN(ctx, conn, aws.StringValue(cluster.ClusterArn))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Cluster (%s) statistics: %s", clusterName, err)
+	}
+
 	d.SetId(aws.StringValue(cluster.ClusterArn))
+	d.Set("active_ec2_services_count", statistics["activeec2servicecount"])
+	d.Set("active_fargate_services_count", statistics["activefargateservicecount"])
+	d.Set("active_services_count", cluster.ActiveServicesCount)
 	d.Set(names.AttrARN, cluster.ClusterArn)
+	d.Set("pending_ec2_tasks_count", statistics["pendingec2taskscount"])
+	d.Set("pending_fargate_tasks_count", statistics["pendingfargatetaskscount"])
 	d.Set("pending_tasks_count", cluster.PendingTasksCount)
+	d.Set("running_ec2_tasks_count", statistics["runningec2taskscount"])
+	d.Set("running_fargate_tasks_count", statistics["runningfargatetaskscount"])
 	d.Set("running_tasks_count", cluster.RunningTasksCount)
 	d.Set("registered_container_instances_count", cluster.RegisteredContainerInstancesCount)
 	d.Set(names.AttrStatus, cluster.Status)
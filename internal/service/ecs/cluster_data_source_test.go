@@ -27,9 +27,16 @@ func TestAccECSClusterDataSource_ecsCluster(t *testing.T) {
 			{
 				Config: testAccClusterDataSourceConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "active_ec2_services_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "active_fargate_services_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "active_services_count", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "pending_ec2_tasks_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "pending_fargate_tasks_count", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "pending_tasks_count", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "registered_container_instances_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "running_ec2_tasks_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "running_fargate_tasks_count", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "running_tasks_count", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "service_connect_defaults.#", resourceName, "service_connect_defaults.#"),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "ACTIVE"),
@@ -18,6 +18,33 @@ const (
 	clusterStatusProvisioning   = "PROVISIONING"
 )
 
+const (
+	clusterAttachmentsStatusUpdateComplete   = "UPDATE_COMPLETE"
+	clusterAttachmentsStatusUpdateInProgress = "UPDATE_IN_PROGRESS"
+)
+
 const (
 	fargateTaskRetirementWaitPeriodValue = "7"
 )
+
+const (
+	errCodeAccessDeniedException         = "AccessDeniedException"
+	errCodeSubscriptionRequiredException = "SubscriptionRequiredException"
+)
+
+const (
+	redeploymentStrategyImmediate      = "IMMEDIATE"
+	redeploymentStrategyNextDeployment = "NEXT_DEPLOYMENT"
+)
+
+func redeploymentStrategy_Values() []string {
+	return []string{
+		redeploymentStrategyImmediate,
+		redeploymentStrategyNextDeployment,
+	}
+}
+
+const (
+	healthEventTypeCodeFargateTaskRetirement = "AWS_ECS_TASK_PATCHING_RETIREMENT"
+	healthServiceECS                         = "ECS"
+)
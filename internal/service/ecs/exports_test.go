@@ -5,5 +5,13 @@ package ecs
 
 // Exports for use in tests only.
 var (
-	ResourceTag = resourceTag
+	ResourceServiceDeploymentGroup = resourceServiceDeploymentGroup
+	ResourceServiceRoute53Records  = resourceServiceRoute53Records
+	ResourceTag                    = resourceTag
+
+	ContainerDefinitionsAreEquivalentIgnoringImages = containerDefinitionsAreEquivalentIgnoringImages
+	DuplicateServiceDeploymentGroupServiceNames     = duplicateServiceDeploymentGroupServiceNames
+	EquivalentNameOrARN                             = equivalentNameOrARN
+	OutdatedNetworkConfigurationDeploymentIDs       = outdatedNetworkConfigurationDeploymentIDs
+	TaskAddress                                     = taskAddress
 )
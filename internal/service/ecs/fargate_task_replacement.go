@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// @SDKResource("aws_ecs_fargate_task_replacement", name="Fargate Task Replacement")
+func resourceFargateTaskReplacement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFargateTaskReplacementCreate,
+		ReadWithoutTimeout:   schema.NoopContext,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reason": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Replaced ahead of scheduled Fargate task retirement",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"redeployed_services": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"stopped_task_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"task_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_steady_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceFargateTaskReplacementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	cluster := d.Get("cluster").(string)
+	taskARNs := flex.ExpandStringSet(d.Get("task_arns").(*schema.Set))
+
+	var tasks []*ecs.Task
+	// DescribeTasks accepts at most 100 tasks per request.
+	for _, chunk := range tfslices.Chunks(taskARNs, 100) {
+		output, err := conn.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   chunk,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECS Cluster (%s) tasks: %s", cluster, err)
+		}
+
+		tasks = append(tasks, output.Tasks...)
+	}
+
+	services := make(map[string]bool)
+	var standaloneTaskARNs []string
+	for _, v := range tasks {
+		// Tasks that have already stopped no longer need replacing.
+		if aws.StringValue(v.LastStatus) == ecs.DesiredStatusStopped || aws.StringValue(v.DesiredStatus) == ecs.DesiredStatusStopped {
+			continue
+		}
+
+		if name := serviceNameFromTaskGroup(aws.StringValue(v.Group)); name != "" {
+			services[name] = true
+		} else {
+			standaloneTaskARNs = append(standaloneTaskARNs, aws.StringValue(v.TaskArn))
+		}
+	}
+
+	serviceNames := make([]string, 0, len(services))
+	for name := range services {
+		serviceNames = append(serviceNames, name)
+	}
+	sort.Strings(serviceNames)
+
+	// Services replace their own tasks via a new deployment, keeping the desired count running throughout.
+	for _, name := range serviceNames {
+		input := &ecs.UpdateServiceInput{
+			Cluster:            aws.String(cluster),
+			ForceNewDeployment: aws.Bool(true),
+			Service:            aws.String(name),
+		}
+
+		log.Printf("[DEBUG] Forcing new deployment of ECS Service (%s) ahead of Fargate task retirement", name)
+		if _, err := conn.UpdateServiceWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", name, err)
+		}
+	}
+
+	reason := d.Get("reason").(string)
+	for _, arn := range standaloneTaskARNs {
+		input := &ecs.StopTaskInput{
+			Cluster: aws.String(cluster),
+			Reason:  aws.String(reason),
+			Task:    aws.String(arn),
+		}
+
+		log.Printf("[DEBUG] Stopping ECS Task (%s) ahead of Fargate task retirement", arn)
+		if _, err := conn.StopTaskWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "stopping ECS Task (%s): %s", arn, err)
+		}
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("redeployed_services", serviceNames)
+	d.Set("stopped_task_arns", standaloneTaskARNs)
+
+	if d.Get("wait_for_steady_state").(bool) {
+		for _, name := range serviceNames {
+			if _, err := waitServiceStable(ctx, conn, name, cluster, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) to reach steady state: %s", name, err)
+			}
+		}
+	}
+
+	return diags
+}
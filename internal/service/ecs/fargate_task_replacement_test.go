@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSFargateTaskReplacement_stoppedTask(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_fargate_task_replacement.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateTaskReplacementConfig_stoppedTask(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redeployed_services.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "stopped_task_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "task_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFargateTaskReplacementConfig_stoppedTask(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+data "aws_region" "current" {}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_fargate_task_replacement" "test" {
+  cluster = aws_ecs_cluster.test.name
+
+  # A task that is no longer running is not replaced.
+  task_arns = ["arn:${data.aws_partition.current.partition}:ecs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:task/${aws_ecs_cluster.test.name}/0123456789abcdef0123456789abcdef"]
+}
+`, rName)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_ecs_fargate_task_retirements", name="Fargate Task Retirements")
+func dataSourceFargateTaskRetirements() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFargateTaskRetirementsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"task": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retirement_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"task_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"task_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceFargateTaskRetirementsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	awsClient := meta.(*conns.AWSClient)
+
+	cluster := d.Get("cluster").(string)
+	events, err := findFargateTaskRetirementEvents(ctx, awsClient.HealthConn(ctx), awsClient.Region)
+
+	if tfawserr.ErrCodeEquals(err, errCodeSubscriptionRequiredException) {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Fargate task retirements: the AWS Health API requires a Business, Enterprise On-Ramp or Enterprise Support plan: %s", err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Fargate task retirements: %s", err)
+	}
+
+	entities := make(map[string][]*health.AffectedEntity)
+	for _, v := range events {
+		eventARN := aws.StringValue(v.Arn)
+		output, err := findAffectedEntitiesByEventARN(ctx, awsClient.HealthConn(ctx), eventARN)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading AWS Health event (%s) affected entities: %s", eventARN, err)
+		}
+
+		entities[eventARN] = output
+	}
+
+	var tasks []*ecs.Task
+	if len(events) > 0 {
+		tasks, err = findTasks(ctx, awsClient.ECSConn(ctx), cluster)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECS Cluster (%s) tasks: %s", cluster, err)
+		}
+	}
+
+	retirements := fargateTaskRetirements(tasks, events, entities)
+	taskARNs := make([]string, 0, len(retirements))
+	tfList := make([]interface{}, 0, len(retirements))
+	for _, v := range retirements {
+		taskARNs = append(taskARNs, v.taskARN)
+		tfList = append(tfList, map[string]interface{}{
+			"event_arn":       v.eventARN,
+			"retirement_time": v.retirementTime,
+			"service_name":    v.serviceName,
+			"task_arn":        v.taskARN,
+		})
+	}
+
+	d.SetId(cluster)
+	if err := d.Set("task", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting task: %s", err)
+	}
+	d.Set("task_arns", taskARNs)
+
+	return diags
+}
+
+type fargateTaskRetirement struct {
+	eventARN       string
+	retirementTime string
+	serviceName    string
+	taskARN        string
+}
+
+// fargateTaskRetirements matches the entities affected by task retirement events against the specified tasks.
+// Affected entities may be reported as either a task ARN or a task ID.
+func fargateTaskRetirements(tasks []*ecs.Task, events []*health.Event, entities map[string][]*health.AffectedEntity) []fargateTaskRetirement {
+	tasksByKey := make(map[string]*ecs.Task)
+	for _, v := range tasks {
+		taskARN := aws.StringValue(v.TaskArn)
+		tasksByKey[taskARN] = v
+		tasksByKey[taskIDFromARN(taskARN)] = v
+	}
+
+	// A task affected by several events is reported against the earliest retirement.
+	events = slices.Clone(events)
+	sort.SliceStable(events, func(i, j int) bool {
+		return aws.TimeValue(events[i].StartTime).Before(aws.TimeValue(events[j].StartTime))
+	})
+
+	seen := make(map[string]bool)
+	var output []fargateTaskRetirement
+	for _, event := range events {
+		eventARN := aws.StringValue(event.Arn)
+
+		for _, entity := range entities[eventARN] {
+			task, ok := tasksByKey[aws.StringValue(entity.EntityValue)]
+			if !ok {
+				task, ok = tasksByKey[aws.StringValue(entity.EntityArn)]
+			}
+			if !ok {
+				continue
+			}
+
+			taskARN := aws.StringValue(task.TaskArn)
+			if seen[taskARN] {
+				continue
+			}
+			seen[taskARN] = true
+
+			var retirementTime string
+			if v := event.StartTime; v != nil {
+				retirementTime = aws.TimeValue(v).Format(time.RFC3339)
+			}
+
+			output = append(output, fargateTaskRetirement{
+				eventARN:       eventARN,
+				retirementTime: retirementTime,
+				serviceName:    serviceNameFromTaskGroup(aws.StringValue(task.Group)),
+				taskARN:        taskARN,
+			})
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		if output[i].retirementTime != output[j].retirementTime {
+			return output[i].retirementTime < output[j].retirementTime
+		}
+		return output[i].taskARN < output[j].taskARN
+	})
+
+	return output
+}
+
+// taskIDFromARN returns the task ID from a task ARN.
+// Both the long ("task/cluster-name/task-id") and short ("task/task-id") ARN formats are supported.
+func taskIDFromARN(s string) string {
+	v, err := arn.Parse(s)
+	if err != nil {
+		return s
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	return parts[len(parts)-1]
+}
+
+// serviceNameFromTaskGroup returns the service name from a task group, or "" if the task was not started by a service.
+func serviceNameFromTaskGroup(group string) string {
+	if v, ok := strings.CutPrefix(group, "service:"); ok {
+		return v
+	}
+
+	return ""
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSFargateTaskRetirementsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecs_fargate_task_retirements.test"
+	clusterResourceName := "aws_ecs_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckHealth(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFargateTaskRetirementsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster", clusterResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "task.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "task_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccPreCheckHealth skips tests when the account does not have a support plan that includes the AWS Health API.
+func testAccPreCheckHealth(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).HealthConn(ctx)
+
+	input := &health.DescribeEventsInput{}
+
+	_, err := conn.DescribeEventsWithContext(ctx, input)
+
+	if acctest.PreCheckSkipError(err) || tfawserr.ErrCodeEquals(err, "SubscriptionRequiredException") {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccFargateTaskRetirementsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+data "aws_ecs_fargate_task_retirements" "test" {
+  cluster = aws_ecs_cluster.test.name
+}
+`, rName)
+}
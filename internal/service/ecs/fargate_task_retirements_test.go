@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/health"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFargateTaskRetirements(t *testing.T) {
+	t.Parallel()
+
+	const (
+		eventARN1 = "arn:aws:health:us-west-2::event/ECS/AWS_ECS_TASK_PATCHING_RETIREMENT/1"        //lintignore:AWSAT003,AWSAT005
+		eventARN2 = "arn:aws:health:us-west-2::event/ECS/AWS_ECS_TASK_PATCHING_RETIREMENT/2"        //lintignore:AWSAT003,AWSAT005
+		taskARN1  = "arn:aws:ecs:us-west-2:123456789012:task/test/11111111111111111111111111111111" //lintignore:AWSAT003,AWSAT005
+		taskARN2  = "arn:aws:ecs:us-west-2:123456789012:task/test/22222222222222222222222222222222" //lintignore:AWSAT003,AWSAT005
+		taskARN3  = "arn:aws:ecs:us-west-2:123456789012:task/33333333333333333333333333333333"      //lintignore:AWSAT003,AWSAT005
+	)
+
+	tasks := []*ecs.Task{
+		{TaskArn: aws.String(taskARN1), Group: aws.String("service:web")},
+		{TaskArn: aws.String(taskARN2), Group: aws.String("family:batch")},
+		{TaskArn: aws.String(taskARN3)},
+	}
+	events := []*health.Event{
+		{Arn: aws.String(eventARN1), StartTime: aws.Time(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC))},
+		{Arn: aws.String(eventARN2), StartTime: aws.Time(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))},
+	}
+	entities := map[string][]*health.AffectedEntity{
+		eventARN1: {
+			{EntityValue: aws.String(taskARN1)},
+			{EntityValue: aws.String("33333333333333333333333333333333")},
+			{EntityValue: aws.String("arn:aws:ecs:us-west-2:123456789012:task/other/44444444444444444444444444444444")}, //lintignore:AWSAT003,AWSAT005
+		},
+		eventARN2: {
+			{EntityValue: aws.String("22222222222222222222222222222222")},
+			{EntityValue: aws.String(taskARN1)},
+		},
+	}
+
+	got := fargateTaskRetirements(tasks, events, entities)
+	want := []fargateTaskRetirement{
+		{eventARN: eventARN2, retirementTime: "2024-06-01T00:00:00Z", serviceName: "web", taskARN: taskARN1},
+		{eventARN: eventARN2, retirementTime: "2024-06-01T00:00:00Z", serviceName: "", taskARN: taskARN2},
+		{eventARN: eventARN1, retirementTime: "2024-06-02T00:00:00Z", serviceName: "", taskARN: taskARN3},
+	}
+
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(fargateTaskRetirement{})); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
@@ -8,11 +8,18 @@ import (
 	"fmt"
 	"log"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	cloudwatchlogstypes "github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/aws/aws-sdk-go/service/health"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -157,3 +164,200 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+func findEFSAccessPointByID(ctx context.Context, conn *efs.EFS, id string) (*efs.AccessPointDescription, error) {
+	input := &efs.DescribeAccessPointsInput{
+		AccessPointId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAccessPointsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, efs.ErrCodeAccessPointNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.AccessPoints) == 0 || output.AccessPoints[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AccessPoints[0], nil
+}
+
+func findFSxFileSystemByID(ctx context.Context, conn *fsx.FSx, id string) (*fsx.FileSystem, error) {
+	input := &fsx.DescribeFileSystemsInput{
+		FileSystemIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := conn.DescribeFileSystemsWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, fsx.ErrCodeFileSystemNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.FileSystems) == 0 || output.FileSystems[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.FileSystems[0], nil
+}
+
+func findLogGroupByName(ctx context.Context, conn *cloudwatchlogs.Client, name string) (*cloudwatchlogstypes.LogGroup, error) {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: aws_sdkv2.String(name),
+	}
+
+	pages := cloudwatchlogs.NewDescribeLogGroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LogGroups {
+			if aws_sdkv2.ToString(v.LogGroupName) == name {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func findTasks(ctx context.Context, conn *ecs.ECS, cluster string) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		Cluster: aws.String(cluster),
+	}
+
+	arns, err := findTaskARNs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findTasksByARNs(ctx, conn, cluster, arns)
+}
+
+func findTaskARNs(ctx context.Context, conn *ecs.ECS, input *ecs.ListTasksInput) ([]*string, error) {
+	var arns []*string
+
+	err := conn.ListTasksPagesWithContext(ctx, input, func(page *ecs.ListTasksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		arns = append(arns, page.TaskArns...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return arns, nil
+}
+
+func findTasksByARNs(ctx context.Context, conn *ecs.ECS, cluster string, arns []*string) ([]*ecs.Task, error) {
+	var output []*ecs.Task
+
+	// DescribeTasks accepts at most 100 tasks per request.
+	for _, chunk := range tfslices.Chunks(arns, 100) {
+		page, err := conn.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+			Cluster: aws.String(cluster),
+			Tasks:   chunk,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Tasks...)
+	}
+
+	return output, nil
+}
+
+func findFargateTaskRetirementEvents(ctx context.Context, conn *health.Health, region string) ([]*health.Event, error) {
+	input := &health.DescribeEventsInput{
+		Filter: &health.EventFilter{
+			EventStatusCodes: aws.StringSlice([]string{health.EventStatusCodeUpcoming, health.EventStatusCodeOpen}),
+			EventTypeCodes:   aws.StringSlice([]string{healthEventTypeCodeFargateTaskRetirement}),
+			Regions:          aws.StringSlice([]string{region}),
+			Services:         aws.StringSlice([]string{healthServiceECS}),
+		},
+	}
+	var output []*health.Event
+
+	err := conn.DescribeEventsPagesWithContext(ctx, input, func(page *health.DescribeEventsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Events {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAffectedEntitiesByEventARN(ctx context.Context, conn *health.Health, arn string) ([]*health.AffectedEntity, error) {
+	input := &health.DescribeAffectedEntitiesInput{
+		Filter: &health.EntityFilter{
+			EventArns: aws.StringSlice([]string{arn}),
+		},
+	}
+	var output []*health.AffectedEntity
+
+	err := conn.DescribeAffectedEntitiesPagesWithContext(ctx, input, func(page *health.DescribeAffectedEntitiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Entities {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
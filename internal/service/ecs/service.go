@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"slices"
 	"strings"
 	"time"
 
@@ -102,10 +103,11 @@ func ResourceService() *schema.Resource {
 				},
 			},
 			"cluster": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentClusterNameOrARN,
 			},
 			"deployment_circuit_breaker": {
 				Type:             schema.TypeList,
@@ -168,7 +170,12 @@ func ResourceService() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return d.Get("scheduling_strategy").(string) == ecs.SchedulingStrategyDaemon
+					if d.Get("scheduling_strategy").(string) == ecs.SchedulingStrategyDaemon {
+						return true
+					}
+
+					// The desired count of a service under Application Auto Scaling control is only set on creation.
+					return d.Id() != "" && d.Get("ignore_desired_count_changes").(bool)
 				},
 			},
 			"enable_ecs_managed_tags": {
@@ -181,6 +188,11 @@ func ResourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"fail_on_rollback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"force_new_deployment": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -191,10 +203,16 @@ func ResourceService() *schema.Resource {
 				ValidateFunc: validation.IntBetween(0, math.MaxInt32),
 			},
 			"iam_role": {
-				Type:     schema.TypeString,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				ForceNew:         true,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressEquivalentRoleNameOrARN,
+			},
+			"ignore_desired_count_changes": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				Computed: true,
+				Default:  false,
 			},
 			"launch_type": {
 				Type:         schema.TypeString,
@@ -287,6 +305,11 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			"outdated_network_configuration_task_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"placement_constraints": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -321,6 +344,11 @@ func ResourceService() *schema.Resource {
 				},
 				ValidateFunc: validation.StringInSlice(ecs.PropagateTags_Values(), false),
 			},
+			"redeployment_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(redeploymentStrategy_Values(), false),
+			},
 			"scheduling_strategy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -502,8 +530,9 @@ func ResourceService() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"task_definition": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEquivalentTaskDefinitionFamilyAndRevisionOrARN,
 			},
 			// modeled after null_resource & aws_api_gateway_deployment
 			// only for _updates in-place_ rather than replacements
@@ -518,6 +547,11 @@ func ResourceService() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"wait_for_steady_state_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -667,11 +701,11 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Service.ServiceArn))
 
-	fn := waitServiceActive
+	fn, timeout := waitServiceActive, d.Timeout(schema.TimeoutCreate)
 	if d.Get("wait_for_steady_state").(bool) {
-		fn = waitServiceStable
+		fn, timeout = serviceStableWaiter(d), serviceStableTimeout(d, timeout)
 	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), timeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -815,6 +849,15 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
 	}
 
+	outdatedTaskARNs, err := findServiceOutdatedNetworkConfigurationTaskARNs(ctx, conn, service)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Service (%s) tasks: %s", d.Id(), err)
+	}
+
+	d.Set("outdated_network_configuration_task_arns", outdatedTaskARNs)
+
+	// service_connect_configuration is only reported for each deployment, so it isn't read.
 	//if err := d.Set("service_connect_configuration", flattenServiceConnectConfiguration(service.ServiceConnectConfiguration)); err != nil {
 	//	return sdkdiag.AppendErrorf(diags, "setting service_connect_configuration: %s", err)
 	//}
@@ -910,6 +953,11 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 		if d.HasChange(names.AttrNetworkConfiguration) {
 			input.NetworkConfiguration = expandNetworkConfiguration(d.Get(names.AttrNetworkConfiguration).([]interface{}))
+
+			// Replace the running tasks so that they use the new ENI configuration straight away.
+			if d.Get("redeployment_strategy").(string) == redeploymentStrategyImmediate {
+				input.ForceNewDeployment = aws.Bool(true)
+			}
 		}
 
 		if d.HasChange("ordered_placement_strategy") {
@@ -990,11 +1038,11 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
 		}
 
-		fn := waitServiceActive
+		fn, timeout := waitServiceActive, d.Timeout(schema.TimeoutUpdate)
 		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
+			fn, timeout = serviceStableWaiter(d), serviceStableTimeout(d, timeout)
 		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), timeout); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1088,9 +1136,106 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 		Resource:  fmt.Sprintf("cluster/%s", cluster),
 	}.String()
 	d.Set("cluster", clusterArn)
+	// wait_for_steady_state, fail_on_rollback and ignore_desired_count_changes aren't read from the API. Set the default values so that
+	// the first plan after import doesn't propose an update.
+	d.Set("ignore_desired_count_changes", false)
+	d.Set("fail_on_rollback", false)
+	d.Set("wait_for_steady_state", false)
 	return []*schema.ResourceData{d}, nil
 }
 
+// serviceStableWaiter returns the function used to wait for the service to reach a steady state.
+func serviceStableWaiter(d *schema.ResourceData) func(context.Context, *ecs.ECS, string, string, time.Duration) (*ecs.Service, error) {
+	if d.Get("fail_on_rollback").(bool) {
+		return waitServiceStableWithoutRollback
+	}
+
+	return waitServiceStable
+}
+
+// serviceStableTimeout returns the time to wait for the service to reach a steady state.
+// Deployments may take much longer than the service API operations, so the wait can be configured separately
+// and falls back to the operation timeout.
+func serviceStableTimeout(d *schema.ResourceData, operationTimeout time.Duration) time.Duration {
+	if v, ok := d.GetOk("wait_for_steady_state_timeout"); ok {
+		if timeout, _ := time.ParseDuration(v.(string)); timeout > 0 {
+			return timeout
+		}
+	}
+
+	return operationTimeout
+}
+
+// findServiceOutdatedNetworkConfigurationTaskARNs returns the ARNs of the service's running tasks
+// that were started by deployments with a network configuration other than the service's.
+func findServiceOutdatedNetworkConfigurationTaskARNs(ctx context.Context, conn *ecs.ECS, service *ecs.Service) ([]string, error) {
+	var output []string
+
+	for _, id := range outdatedNetworkConfigurationDeploymentIDs(service) {
+		input := &ecs.ListTasksInput{
+			Cluster:   service.ClusterArn,
+			StartedBy: aws.String(id),
+		}
+
+		arns, err := findTaskARNs(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, aws.StringValueSlice(arns)...)
+	}
+
+	return output, nil
+}
+
+// outdatedNetworkConfigurationDeploymentIDs returns the IDs of the service's deployments with running tasks
+// whose network configuration differs from the service's.
+func outdatedNetworkConfigurationDeploymentIDs(service *ecs.Service) []string {
+	var ids []string
+
+	for _, v := range service.Deployments {
+		if aws.Int64Value(v.RunningCount) == 0 {
+			continue
+		}
+
+		if !networkConfigurationsEqual(v.NetworkConfiguration, service.NetworkConfiguration) {
+			ids = append(ids, aws.StringValue(v.Id))
+		}
+	}
+
+	return ids
+}
+
+func networkConfigurationsEqual(a, b *ecs.NetworkConfiguration) bool {
+	var x, y *ecs.AwsVpcConfiguration
+	if a != nil {
+		x = a.AwsvpcConfiguration
+	}
+	if b != nil {
+		y = b.AwsvpcConfiguration
+	}
+
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+
+	assignPublicIP := func(v *ecs.AwsVpcConfiguration) string {
+		if v.AssignPublicIp == nil {
+			return ecs.AssignPublicIpDisabled
+		}
+		return aws.StringValue(v.AssignPublicIp)
+	}
+	stringSetsEqual := func(a, b []*string) bool {
+		x, y := aws.StringValueSlice(a), aws.StringValueSlice(b)
+		slices.Sort(x)
+		slices.Sort(y)
+		return slices.Equal(slices.Compact(x), slices.Compact(y))
+	}
+
+	return assignPublicIP(x) == assignPublicIP(y) && stringSetsEqual(x.SecurityGroups, y.SecurityGroups) && stringSetsEqual(x.Subnets, y.Subnets)
+}
+
 func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// clears diff to avoid extraneous diffs but lets it pass for triggering update
 	fnd := false
@@ -1668,6 +1813,34 @@ func buildFamilyAndRevisionFromARN(arn string) string {
 	return strings.Split(arn, "/")[1]
 }
 
+// The cluster, IAM role and task definition are saved in the format in which they are configured,
+// which isn't known on import. Suppress differences between the equivalent formats so that
+// an imported service isn't replaced or updated.
+
+func suppressEquivalentClusterNameOrARN(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentNameOrARN(old, new, GetClusterNameFromARN)
+}
+
+func suppressEquivalentRoleNameOrARN(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentNameOrARN(old, new, GetRoleNameFromARN)
+}
+
+func suppressEquivalentTaskDefinitionFamilyAndRevisionOrARN(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentNameOrARN(old, new, buildFamilyAndRevisionFromARN)
+}
+
+func equivalentNameOrARN(old, new string, nameFromARN func(string) string) bool {
+	if old == "" || new == "" || arn.IsARN(old) == arn.IsARN(new) {
+		return old == new
+	}
+
+	if arn.IsARN(old) {
+		return nameFromARN(old) == new
+	}
+
+	return old == nameFromARN(new)
+}
+
 // GetRoleNameFromARN parses a role name from a fully qualified ARN
 //
 // When providing a role name with a path, it must be prefixed with the full path
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ecs_service_deployment_group", name="Service Deployment Group")
+func resourceServiceDeploymentGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceDeploymentGroupCreate,
+		ReadWithoutTimeout:   resourceServiceDeploymentGroupRead,
+		UpdateWithoutTimeout: resourceServiceDeploymentGroupUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentClusterNameOrARN,
+			},
+			"fail_on_rollback": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"service": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"task_definition": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppressEquivalentTaskDefinitionFamilyAndRevisionOrARN,
+						},
+					},
+				},
+			},
+		},
+
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if v := duplicateServiceDeploymentGroupServiceNames(d.Get("service").([]interface{})); len(v) > 0 {
+				return fmt.Errorf("duplicate service names: %s", strings.Join(v, ", "))
+			}
+
+			return nil
+		},
+	}
+}
+
+func resourceServiceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	if err := deployServiceDeploymentGroup(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ECS Service Deployment Group: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourceServiceDeploymentGroupRead(ctx, d, meta)...)
+}
+
+func resourceServiceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	cluster := d.Get("cluster").(string)
+	tfList := d.Get("service").([]interface{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name := tfMap[names.AttrName].(string)
+
+		service, err := FindServiceNoTagsByID(ctx, conn, name, cluster)
+
+		// A missing service can't be deployed to, so recreate the group once the service exists again.
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] ECS Service (%s) in ECS Service Deployment Group (%s) not found, removing from state", name, d.Id())
+			d.SetId("")
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ECS Service Deployment Group (%s) service (%s): %s", d.Id(), name, err)
+		}
+
+		tfMap["task_definition"] = aws.StringValue(service.TaskDefinition)
+	}
+
+	if err := d.Set("service", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service: %s", err)
+	}
+
+	return diags
+}
+
+func resourceServiceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	if d.HasChange("service") {
+		if err := deployServiceDeploymentGroup(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ECS Service Deployment Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceServiceDeploymentGroupRead(ctx, d, meta)...)
+}
+
+// deployServiceDeploymentGroup deploys the group's task definitions to its services in the configured order.
+// Each service that isn't already running its task definition is updated and must reach a steady state
+// before the next service is deployed. The timeout applies to the group as a whole.
+func deployServiceDeploymentGroup(ctx context.Context, conn *ecs.ECS, d *schema.ResourceData, timeout time.Duration) error {
+	cluster := d.Get("cluster").(string)
+	deadline := time.Now().Add(timeout)
+
+	waitStable := waitServiceStable
+	if d.Get("fail_on_rollback").(bool) {
+		waitStable = waitServiceStableWithoutRollback
+	}
+
+	for _, tfMapRaw := range d.Get("service").([]interface{}) {
+		tfMap := tfMapRaw.(map[string]interface{})
+		name, taskDefinition := tfMap[names.AttrName].(string), tfMap["task_definition"].(string)
+
+		service, err := FindServiceNoTagsByID(ctx, conn, name, cluster)
+
+		if err != nil {
+			return fmt.Errorf("reading ECS Service (%s): %w", name, err)
+		}
+
+		if equivalentNameOrARN(aws.StringValue(service.TaskDefinition), taskDefinition, buildFamilyAndRevisionFromARN) {
+			continue
+		}
+
+		input := &ecs.UpdateServiceInput{
+			Cluster:        aws.String(cluster),
+			Service:        aws.String(name),
+			TaskDefinition: aws.String(taskDefinition),
+		}
+
+		log.Printf("[DEBUG] Deploying ECS Task Definition (%s) to ECS Service (%s)", taskDefinition, name)
+		if _, err := conn.UpdateServiceWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating ECS Service (%s): %w", name, err)
+		}
+
+		if _, err := waitStable(ctx, conn, name, cluster, time.Until(deadline)); err != nil {
+			return fmt.Errorf("waiting for ECS Service (%s) to reach steady state: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// duplicateServiceDeploymentGroupServiceNames returns the service names that occur more than once in the group.
+func duplicateServiceDeploymentGroupServiceNames(tfList []interface{}) []string {
+	seen := make(map[string]int)
+	var duplicates []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := tfMap[names.AttrName].(string)
+		if name == "" {
+			continue
+		}
+
+		seen[name]++
+		if seen[name] == 2 {
+			duplicates = append(duplicates, name)
+		}
+	}
+
+	return duplicates
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDuplicateServiceDeploymentGroupServiceNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList []interface{}
+		want   []string
+	}{
+		"empty": {},
+		"unique": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrName: "api"},
+				map[string]interface{}{names.AttrName: "worker"},
+			},
+		},
+		"duplicates": {
+			tfList: []interface{}{
+				map[string]interface{}{names.AttrName: "api"},
+				map[string]interface{}{names.AttrName: "worker"},
+				map[string]interface{}{names.AttrName: "api"},
+				map[string]interface{}{names.AttrName: "api"},
+				map[string]interface{}{names.AttrName: "worker"},
+			},
+			want: []string{"api", "worker"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfecs.DuplicateServiceDeploymentGroupServiceNames(testCase.tfList)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccECSServiceDeploymentGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentGroupConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "cluster", "aws_ecs_cluster.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_rollback", "false"),
+					resource.TestCheckResourceAttr(resourceName, "service.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "service.0.name", "aws_ecs_service.api", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "service.0.task_definition", "aws_ecs_task_definition.api.1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service.1.name", "aws_ecs_service.worker", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "service.1.task_definition", "aws_ecs_task_definition.worker.1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccServiceDeploymentGroupConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "service.0.task_definition", "aws_ecs_task_definition.api.0", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "service.1.task_definition", "aws_ecs_task_definition.worker.0", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSServiceDeploymentGroup_duplicateServices(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceDeploymentGroupConfig_duplicateServices(rName),
+				ExpectError: regexache.MustCompile(`duplicate service names: api`),
+			},
+		},
+	})
+}
+
+func testAccServiceDeploymentGroupConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "api" {
+  count = 2
+
+  family = "%[1]s-api"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": ${128 * (count.index + 1)},
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_task_definition" "worker" {
+  count = 2
+
+  family = "%[1]s-worker"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": ${128 * (count.index + 1)},
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+# The services have no running tasks so that deployments reach a steady state without container instances.
+resource "aws_ecs_service" "api" {
+  name            = "%[1]s-api"
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.api[0].arn
+  desired_count   = 0
+
+  lifecycle {
+    ignore_changes = [task_definition]
+  }
+}
+
+resource "aws_ecs_service" "worker" {
+  name            = "%[1]s-worker"
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.worker[0].arn
+  desired_count   = 0
+
+  lifecycle {
+    ignore_changes = [task_definition]
+  }
+}
+`, rName)
+}
+
+func testAccServiceDeploymentGroupConfig_basic(rName string, index int) string {
+	return acctest.ConfigCompose(testAccServiceDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_ecs_service_deployment_group" "test" {
+  cluster = aws_ecs_cluster.test.name
+
+  service {
+    name            = aws_ecs_service.api.name
+    task_definition = aws_ecs_task_definition.api[%[1]d].arn
+  }
+
+  service {
+    name            = aws_ecs_service.worker.name
+    task_definition = aws_ecs_task_definition.worker[%[1]d].arn
+  }
+}
+`, index))
+}
+
+func testAccServiceDeploymentGroupConfig_duplicateServices(rName string) string {
+	return acctest.ConfigCompose(testAccServiceDeploymentGroupConfig_base(rName), `
+resource "aws_ecs_service_deployment_group" "test" {
+  cluster = aws_ecs_cluster.test.name
+
+  service {
+    name            = aws_ecs_service.api.name
+    task_definition = aws_ecs_task_definition.api[0].arn
+  }
+
+  service {
+    name            = aws_ecs_service.api.name
+    task_definition = aws_ecs_task_definition.api[1].arn
+  }
+}
+`)
+}
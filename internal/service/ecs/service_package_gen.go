@@ -35,6 +35,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceContainerDefinition,
 			TypeName: "aws_ecs_container_definition",
 		},
+		{
+			Factory:  dataSourceFargateTaskRetirements,
+			TypeName: "aws_ecs_fargate_task_retirements",
+			Name:     "Fargate Task Retirements",
+		},
 		{
 			Factory:  DataSourceService,
 			TypeName: "aws_ecs_service",
@@ -77,6 +82,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceClusterCapacityProviders,
 			TypeName: "aws_ecs_cluster_capacity_providers",
 		},
+		{
+			Factory:  resourceFargateTaskReplacement,
+			TypeName: "aws_ecs_fargate_task_replacement",
+			Name:     "Fargate Task Replacement",
+		},
 		{
 			Factory:  ResourceService,
 			TypeName: "aws_ecs_service",
@@ -85,6 +95,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceServiceDeploymentGroup,
+			TypeName: "aws_ecs_service_deployment_group",
+			Name:     "Service Deployment Group",
+		},
+		{
+			Factory:  resourceServiceRoute53Records,
+			TypeName: "aws_ecs_service_route53_records",
+			Name:     "Service Route 53 Records",
+		},
 		{
 			Factory:  resourceTag,
 			TypeName: "aws_ecs_tag",
@@ -0,0 +1,529 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Each task has its own record in a set of multivalue answer records.
+	serviceRoute53RecordsRoutingPolicyMultivalue = "MULTIVALUE"
+	// Each task has its own weighted record, with the same weight.
+	serviceRoute53RecordsRoutingPolicyWeighted = "WEIGHTED"
+)
+
+func serviceRoute53RecordsRoutingPolicy_Values() []string {
+	return []string{
+		serviceRoute53RecordsRoutingPolicyMultivalue,
+		serviceRoute53RecordsRoutingPolicyWeighted,
+	}
+}
+
+const (
+	// ChangeResourceRecordSets accepts at most 1000 changes per request.
+	serviceRoute53RecordsChangesMax = 1000
+	// Task IDs are 32 characters long and set identifiers at most 128 characters.
+	serviceRoute53RecordsSetIdentifierPrefixMaxLen = 96
+)
+
+// @SDKResource("aws_ecs_service_route53_records", name="Service Route 53 Records")
+func resourceServiceRoute53Records() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceServiceRoute53RecordsCreate,
+		ReadWithoutTimeout:   resourceServiceRoute53RecordsRead,
+		UpdateWithoutTimeout: resourceServiceRoute53RecordsUpdate,
+		DeleteWithoutTimeout: resourceServiceRoute53RecordsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentClusterNameOrARN,
+			},
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"healthy_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(strings.TrimSuffix(v.(string), "."))
+				},
+			},
+			"records": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"routing_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      serviceRoute53RecordsRoutingPolicyMultivalue,
+				ValidateFunc: validation.StringInSlice(serviceRoute53RecordsRoutingPolicy_Values(), false),
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"set_identifier_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ecs-",
+				ValidateFunc: validation.StringLenBetween(1, serviceRoute53RecordsSetIdentifierPrefixMaxLen),
+			},
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      route53.RRTypeA,
+				ValidateFunc: validation.StringInSlice([]string{route53.RRTypeA, route53.RRTypeAaaa}, false),
+			},
+			names.AttrWeight: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(0, 255),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffServiceRoute53Records,
+		),
+	}
+}
+
+func resourceServiceRoute53RecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	route53Conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	if err := syncServiceRoute53Records(ctx, conn, route53Conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ECS Service Route 53 Records: %s", err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return append(diags, resourceServiceRoute53RecordsRead(ctx, d, meta)...)
+}
+
+func resourceServiceRoute53RecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	route53Conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	fqdn, err := serviceRoute53RecordsFQDN(ctx, route53Conn, d.Get("zone_id").(string), d.Get(names.AttrName).(string))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Hosted Zone (%s) for ECS Service Route 53 Records (%s) not found, removing from state", d.Get("zone_id").(string), d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Service Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	recordSets, err := findServiceRoute53RecordSets(ctx, route53Conn, d.Get("zone_id").(string), fqdn, d.Get(names.AttrType).(string), d.Get("set_identifier_prefix").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ECS Service Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	d.Set("fqdn", strings.TrimSuffix(fqdn, "."))
+	if err := d.Set("records", flattenServiceRoute53RecordSets(recordSets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+	}
+
+	return diags
+}
+
+func resourceServiceRoute53RecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	route53Conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	if err := syncServiceRoute53Records(ctx, conn, route53Conn, d); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ECS Service Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceServiceRoute53RecordsRead(ctx, d, meta)...)
+}
+
+func resourceServiceRoute53RecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	route53Conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := d.Get("zone_id").(string)
+	fqdn, err := serviceRoute53RecordsFQDN(ctx, route53Conn, zoneID, d.Get(names.AttrName).(string))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECS Service Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	recordSets, err := findServiceRoute53RecordSets(ctx, route53Conn, zoneID, fqdn, d.Get(names.AttrType).(string), d.Get("set_identifier_prefix").(string))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECS Service Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	var changes []*route53.Change
+	for _, v := range recordSets {
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: v,
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting ECS Service Route 53 Records: %s", d.Id())
+	if err := changeServiceRoute53RecordSets(ctx, route53Conn, zoneID, changes); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ECS Service Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// customizeDiffServiceRoute53Records plans the records for the service's current tasks,
+// so that every plan shows the changes needed to bring the records in sync with the tasks.
+func customizeDiffServiceRoute53Records(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"cluster", "healthy_only", "service", "set_identifier_prefix"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+
+	addresses, err := findServiceTaskAddresses(ctx, conn, d.Get("cluster").(string), d.Get("service").(string), d.Get(names.AttrType).(string), d.Get("healthy_only").(bool))
+
+	if tfresource.NotFound(err) {
+		// The cluster or service doesn't exist yet.
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading ECS Service (%s) tasks: %w", d.Get("service").(string), err)
+	}
+
+	tfList := flattenServiceRoute53RecordAddresses(d.Get("set_identifier_prefix").(string), addresses)
+
+	if o := d.Get("records").(*schema.Set); d.Id() != "" && o.Equal(schema.NewSet(o.F, tfList)) {
+		return nil
+	}
+
+	return d.SetNew("records", tfList)
+}
+
+// syncServiceRoute53Records creates, updates and deletes records so that the service's current tasks,
+// and only those, have a record.
+func syncServiceRoute53Records(ctx context.Context, conn *ecs.ECS, route53Conn *route53.Route53, d *schema.ResourceData) error {
+	zoneID := d.Get("zone_id").(string)
+	recordType := d.Get(names.AttrType).(string)
+	prefix := d.Get("set_identifier_prefix").(string)
+
+	fqdn, err := serviceRoute53RecordsFQDN(ctx, route53Conn, zoneID, d.Get(names.AttrName).(string))
+
+	if err != nil {
+		return err
+	}
+
+	// Apply the planned records, so that the result matches the plan even if the service's tasks have changed since.
+	records := make(map[string]string)
+
+	if d.GetRawPlan().GetAttr("records").IsWhollyKnown() {
+		for _, tfMapRaw := range d.Get("records").(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			records[tfMap["set_identifier"].(string)] = tfMap["address"].(string)
+		}
+	} else {
+		addresses, err := findServiceTaskAddresses(ctx, conn, d.Get("cluster").(string), d.Get("service").(string), recordType, d.Get("healthy_only").(bool))
+
+		if err != nil {
+			return fmt.Errorf("reading ECS Service (%s) tasks: %w", d.Get("service").(string), err)
+		}
+
+		for taskID, address := range addresses {
+			records[prefix+taskID] = address
+		}
+	}
+
+	recordSets, err := findServiceRoute53RecordSets(ctx, route53Conn, zoneID, fqdn, recordType, prefix)
+
+	if err != nil {
+		return err
+	}
+
+	var changes []*route53.Change
+
+	for _, v := range recordSets {
+		if _, ok := records[aws.StringValue(v.SetIdentifier)]; !ok {
+			changes = append(changes, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: v,
+			})
+		}
+	}
+
+	for _, setIdentifier := range sortedKeys(records) {
+		recordSet := &route53.ResourceRecordSet{
+			Name: aws.String(fqdn),
+			ResourceRecords: []*route53.ResourceRecord{{
+				Value: aws.String(records[setIdentifier]),
+			}},
+			SetIdentifier: aws.String(setIdentifier),
+			TTL:           aws.Int64(int64(d.Get("ttl").(int))),
+			Type:          aws.String(recordType),
+		}
+
+		switch d.Get("routing_policy").(string) {
+		case serviceRoute53RecordsRoutingPolicyMultivalue:
+			recordSet.MultiValueAnswer = aws.Bool(true)
+		case serviceRoute53RecordsRoutingPolicyWeighted:
+			recordSet.Weight = aws.Int64(int64(d.Get(names.AttrWeight).(int)))
+		}
+
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionUpsert),
+			ResourceRecordSet: recordSet,
+		})
+	}
+
+	return changeServiceRoute53RecordSets(ctx, route53Conn, zoneID, changes)
+}
+
+func changeServiceRoute53RecordSets(ctx context.Context, conn *route53.Route53, zoneID string, changes []*route53.Change) error {
+	for _, chunk := range tfslices.Chunks(changes, serviceRoute53RecordsChangesMax) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: chunk,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		changeInfo, err := tfroute53.ChangeResourceRecordSets(ctx, conn, input)
+
+		if err != nil {
+			return fmt.Errorf("changing Route 53 Records: %w", err)
+		}
+
+		if err := tfroute53.WaitForRecordSetToSync(ctx, conn, tfroute53.CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return fmt.Errorf("waiting for Route 53 Records change (%s): %w", aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	return nil
+}
+
+func serviceRoute53RecordsFQDN(ctx context.Context, conn *route53.Route53, zoneID, name string) (string, error) {
+	zone, err := tfroute53.FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return "", err
+	}
+
+	return tfroute53.FQDN(strings.ToLower(tfroute53.ExpandRecordName(name, aws.StringValue(zone.HostedZone.Name)))), nil
+}
+
+// findServiceRoute53RecordSets returns the record sets with the specified name and type
+// whose set identifier begins with the specified prefix.
+func findServiceRoute53RecordSets(ctx context.Context, conn *route53.Route53, zoneID, fqdn, recordType, prefix string) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(fqdn),
+		StartRecordType: aws.String(recordType),
+	}
+	var output []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if fqdn != strings.ToLower(tfroute53.CleanRecordName(aws.StringValue(v.Name))) || recordType != strings.ToUpper(aws.StringValue(v.Type)) {
+				return false
+			}
+
+			if setIdentifier := aws.StringValue(v.SetIdentifier); setIdentifier != "" && strings.HasPrefix(setIdentifier, prefix) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findServiceTaskAddresses returns the private IP addresses of the service's running tasks, keyed by task ID.
+// Only tasks that use the awsvpc network mode have their own IP address.
+func findServiceTaskAddresses(ctx context.Context, conn *ecs.ECS, cluster, service, recordType string, healthyOnly bool) (map[string]string, error) {
+	input := &ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		DesiredStatus: aws.String(ecs.DesiredStatusRunning),
+		ServiceName:   aws.String(service),
+	}
+
+	arns, err := findTaskARNs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := findTasksByARNs(ctx, conn, cluster, arns)
+
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string)
+
+	for _, task := range tasks {
+		if aws.StringValue(task.LastStatus) != ecs.DesiredStatusRunning {
+			continue
+		}
+
+		if healthyOnly && aws.StringValue(task.HealthStatus) != ecs.HealthStatusHealthy {
+			continue
+		}
+
+		taskARN, err := arn.Parse(aws.StringValue(task.TaskArn))
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Task ARNs have the format task/cluster-name/task-id.
+		parts := strings.Split(taskARN.Resource, "/")
+		taskID := parts[len(parts)-1]
+
+		if address := taskAddress(task, recordType); address != "" {
+			addresses[taskID] = address
+		}
+	}
+
+	return addresses, nil
+}
+
+func taskAddress(task *ecs.Task, recordType string) string {
+	for _, container := range task.Containers {
+		for _, v := range container.NetworkInterfaces {
+			var address string
+
+			switch recordType {
+			case route53.RRTypeA:
+				address = aws.StringValue(v.PrivateIpv4Address)
+			case route53.RRTypeAaaa:
+				address = aws.StringValue(v.Ipv6Address)
+			}
+
+			if address != "" {
+				return address
+			}
+		}
+	}
+
+	return ""
+}
+
+func flattenServiceRoute53RecordSets(apiObjects []*route53.ResourceRecordSet) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var address string
+		if len(apiObject.ResourceRecords) > 0 {
+			address = aws.StringValue(apiObject.ResourceRecords[0].Value)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"address":        address,
+			"set_identifier": aws.StringValue(apiObject.SetIdentifier),
+		})
+	}
+
+	return tfList
+}
+
+func flattenServiceRoute53RecordAddresses(prefix string, addresses map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, taskID := range sortedKeys(addresses) {
+		tfList = append(tfList, map[string]interface{}{
+			"address":        addresses[taskID],
+			"set_identifier": prefix + taskID,
+		})
+	}
+
+	return tfList
+}
+
+// sortedKeys returns the keys of the specified map in a stable order.
+func sortedKeys(m map[string]string) []string {
+	keys := tfmaps.Keys(m)
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfecs "github.com/hashicorp/terraform-provider-aws/internal/service/ecs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestTaskAddress(t *testing.T) {
+	t.Parallel()
+
+	task := &ecs.Task{
+		Containers: []*ecs.Container{
+			{},
+			{
+				NetworkInterfaces: []*ecs.NetworkInterface{{
+					Ipv6Address:        aws.String("2001:db8::1"),
+					PrivateIpv4Address: aws.String("10.0.0.1"),
+				}},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		task       *ecs.Task
+		recordType string
+		want       string
+	}{
+		"A": {
+			task:       task,
+			recordType: "A",
+			want:       "10.0.0.1",
+		},
+		"AAAA": {
+			task:       task,
+			recordType: "AAAA",
+			want:       "2001:db8::1",
+		},
+		"no network interfaces": {
+			task:       &ecs.Task{Containers: []*ecs.Container{{}}},
+			recordType: "A",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfecs.TaskAddress(testCase.task, testCase.recordType); got != testCase.want {
+				t.Errorf("TaskAddress() = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccECSServiceRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceRoute53RecordsConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fqdn", fmt.Sprintf("api.%s.test", rName)),
+					resource.TestCheckResourceAttr(resourceName, "healthy_only", "false"),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "1"),
+					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "records.*", map[string]*regexache.Regexp{
+						"address":        regexache.MustCompile(`^10\.`),
+						"set_identifier": regexache.MustCompile(`^ecs-[0-9a-f]{32}$`),
+					}),
+					resource.TestCheckResourceAttr(resourceName, "routing_policy", "MULTIVALUE"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "A"),
+				),
+			},
+			{
+				Config: testAccServiceRoute53RecordsConfig_basic(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// The service is created in the same apply as the records, in a cluster that already exists.
+func TestAccECSServiceRoute53Records_existingCluster(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service_route53_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_launchTypeFargateBase(rName),
+			},
+			{
+				Config: testAccServiceRoute53RecordsConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "records.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceRoute53RecordsConfig_basic(rName string, desiredCount int) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateAndWait(rName, desiredCount, true), fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s.test"
+
+  vpc {
+    vpc_id = aws_vpc.test.id
+  }
+}
+
+resource "aws_ecs_service_route53_records" "test" {
+  cluster = aws_ecs_cluster.test.name
+  service = aws_ecs_service.test.name
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "api"
+}
+`, rName))
+}
@@ -7,17 +7,21 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -62,6 +66,55 @@ func Test_GetRoleNameFromARN(t *testing.T) {
 	}
 }
 
+func Test_EquivalentNameOrARN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{"both empty", "", "", true},
+		{"old empty", "", "my-cluster", false},
+		{"same name", "my-cluster", "my-cluster", true},
+		{"different names", "my-cluster", "other-cluster", false},
+		{
+			"ARN and name",
+			"arn:aws:ecs:us-west-2:0123456789:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			"my-cluster",
+			true,
+		},
+		{
+			"name and ARN",
+			"my-cluster",
+			"arn:aws:ecs:us-west-2:0123456789:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			true,
+		},
+		{
+			"ARN and different name",
+			"arn:aws:ecs:us-west-2:0123456789:cluster/my-cluster", //lintignore:AWSAT003,AWSAT005
+			"other-cluster",
+			false,
+		},
+		{
+			"different ARNs",
+			"arn:aws:ecs:us-west-2:0123456789:cluster/my-cluster",    //lintignore:AWSAT003,AWSAT005
+			"arn:aws:ecs:us-west-2:0123456789:cluster/other-cluster", //lintignore:AWSAT003,AWSAT005
+			false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tfecs.EquivalentNameOrARN(tt.old, tt.new, tfecs.GetClusterNameFromARN); got != tt.want {
+				t.Errorf("EquivalentNameOrARN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_GetClustereNameFromARN(t *testing.T) {
 	t.Parallel()
 
@@ -88,6 +141,91 @@ func Test_GetClustereNameFromARN(t *testing.T) {
 	}
 }
 
+func TestOutdatedNetworkConfigurationDeploymentIDs(t *testing.T) {
+	t.Parallel()
+
+	networkConfiguration := func(assignPublicIP *string, securityGroups, subnets []string) *ecs.NetworkConfiguration {
+		return &ecs.NetworkConfiguration{
+			AwsvpcConfiguration: &ecs.AwsVpcConfiguration{
+				AssignPublicIp: assignPublicIP,
+				SecurityGroups: aws.StringSlice(securityGroups),
+				Subnets:        aws.StringSlice(subnets),
+			},
+		}
+	}
+	deployment := func(id string, runningCount int64, networkConfiguration *ecs.NetworkConfiguration) *ecs.Deployment {
+		return &ecs.Deployment{
+			Id:                   aws.String(id),
+			NetworkConfiguration: networkConfiguration,
+			RunningCount:         aws.Int64(runningCount),
+		}
+	}
+	current := networkConfiguration(nil, []string{"sg-1", "sg-2"}, []string{"subnet-1", "subnet-2"})
+
+	testCases := map[string]struct {
+		service *ecs.Service
+		want    []string
+	}{
+		"no network configuration": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{deployment("ecs-svc/1", 1, nil)},
+			},
+			want: nil,
+		},
+		"same network configuration": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					deployment("ecs-svc/1", 1, networkConfiguration(aws.String(ecs.AssignPublicIpDisabled), []string{"sg-2", "sg-1"}, []string{"subnet-2", "subnet-1"})),
+				},
+				NetworkConfiguration: current,
+			},
+			want: nil,
+		},
+		"outdated security groups": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					deployment("ecs-svc/2", 1, current),
+					deployment("ecs-svc/1", 2, networkConfiguration(nil, []string{"sg-1"}, []string{"subnet-1", "subnet-2"})),
+				},
+				NetworkConfiguration: current,
+			},
+			want: []string{"ecs-svc/1"},
+		},
+		"outdated public IP": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					deployment("ecs-svc/2", 1, current),
+					deployment("ecs-svc/1", 2, networkConfiguration(aws.String(ecs.AssignPublicIpEnabled), []string{"sg-1", "sg-2"}, []string{"subnet-1", "subnet-2"})),
+				},
+				NetworkConfiguration: current,
+			},
+			want: []string{"ecs-svc/1"},
+		},
+		"outdated without running tasks": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					deployment("ecs-svc/2", 1, current),
+					deployment("ecs-svc/1", 0, networkConfiguration(nil, []string{"sg-1"}, []string{"subnet-1"})),
+				},
+				NetworkConfiguration: current,
+			},
+			want: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfecs.OutdatedNetworkConfigurationDeploymentIDs(testCase.service), testCase.want; !reflect.DeepEqual(got, want) {
+				t.Errorf("OutdatedNetworkConfigurationDeploymentIDs() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
 func TestAccECSService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -123,6 +261,42 @@ func TestAccECSService_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSService_ignoreDesiredCountChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_ignoreDesiredCountChanges(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_desired_count_changes", "true"),
+				),
+			},
+			{
+				Config: testAccServiceConfig_ignoreDesiredCountChanges(rName, 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_basicImport(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -403,6 +577,17 @@ func TestAccECSService_iamRole(t *testing.T) {
 					testAccCheckServiceExists(ctx, resourceName, &service),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_iamRole(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -534,6 +719,17 @@ func TestAccECSService_alarmsAdd(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "alarms.#", "0"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_noAlarms(rName),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccServiceConfig_alarms(rName, true),
 				Check: resource.ComposeTestCheckFunc(
@@ -598,6 +794,17 @@ func TestAccECSService_DeploymentValues_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "deployment_minimum_healthy_percent", "100"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_deploymentValues(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -648,6 +855,17 @@ func TestAccECSService_deploymentCircuitBreaker(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "deployment_circuit_breaker.0.rollback", "true"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_deploymentCircuitBreaker(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -702,6 +920,17 @@ func TestAccECSService_clusterName(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cluster", rName),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_clusterName(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -725,6 +954,17 @@ func TestAccECSService_alb(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "1"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_alb(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -748,6 +988,17 @@ func TestAccECSService_multipleTargetGroups(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "2"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_multipleTargetGroups(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -950,6 +1201,34 @@ func TestAccECSService_PlacementConstraints_emptyExpression(t *testing.T) {
 	})
 }
 
+func TestAccECSService_failOnRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_failOnRollback(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "fail_on_rollback", "true"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
+				),
+			},
+			{
+				Config:      testAccServiceConfig_failOnRollback(rName, true),
+				ExpectError: regexache.MustCompile(`deployment \(.+\) (failed|was rolled back)`),
+			},
+		},
+	})
+}
+
 func TestAccECSService_LaunchTypeFargate_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1062,6 +1341,48 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 	})
 }
 
+func TestAccECSService_LaunchTypeFargate_waitForSteadyStateTimeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_launchTypeFargateAndWaitTimeout(rName, 1, "30m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "desired_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state", "true"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state_timeout", "30m"),
+				),
+			},
+			{
+				Config: testAccServiceConfig_launchTypeFargateAndWaitTimeout(rName, 2, "45m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "desired_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_steady_state_timeout", "45m"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateId:     fmt.Sprintf("%s/%s", rName, rName),
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Resource currently defaults to importing task_definition as family:revision
+				// and wait_for_steady_state and wait_for_steady_state_timeout are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state", "wait_for_steady_state_timeout"},
+			},
+		},
+	})
+}
+
 func TestAccECSService_LaunchTypeFargate_updateWaitForSteadyState(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1138,6 +1459,40 @@ func TestAccECSService_LaunchTypeEC2_network(t *testing.T) {
 	})
 }
 
+func TestAccECSService_LaunchTypeEC2_redeploymentStrategy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_networkConfiguration(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.security_groups.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "outdated_network_configuration_task_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "redeployment_strategy", ""),
+				),
+			},
+			{
+				Config: testAccServiceConfig_networkConfigurationRedeploymentStrategy(rName, "IMMEDIATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "network_configuration.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outdated_network_configuration_task_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "redeployment_strategy", "IMMEDIATE"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_DaemonSchedulingStrategy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1226,6 +1581,17 @@ func TestAccECSService_ServiceRegistries_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "1"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_registries(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -1249,6 +1615,17 @@ func TestAccECSService_ServiceRegistries_container(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "service_registries.#", "1"),
 				),
 			},
+			{
+				ResourceName:       resourceName,
+				ImportState:        true,
+				ImportStateIdFunc:  testAccServiceImportStateIdFunc(resourceName),
+				ImportStatePersist: true,
+			},
+			{
+				// The imported service must not be replaced or updated.
+				Config:   testAccServiceConfig_registriesContainer(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -1627,6 +2004,22 @@ func testAccCheckServiceDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+func testAccServiceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		cluster := rs.Primary.Attributes["cluster"]
+		if arn.IsARN(cluster) {
+			cluster = tfecs.GetClusterNameFromARN(cluster)
+		}
+
+		return fmt.Sprintf("%s/%s", cluster, rs.Primary.Attributes[names.AttrName]), nil
+	}
+}
+
 func testAccCheckServiceExists(ctx context.Context, name string, service *ecs.Service) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1697,6 +2090,38 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_ignoreDesiredCountChanges(rName string, desiredCount int) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name                         = %[1]q
+  cluster                      = aws_ecs_cluster.test.id
+  task_definition              = aws_ecs_task_definition.test.arn
+  desired_count                = %[2]d
+  ignore_desired_count_changes = true
+}
+`, rName, desiredCount)
+}
+
 func testAccServiceConfig_modified(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -1831,6 +2256,53 @@ resource "aws_ecs_service" "test" {
 `, rName, assignPublicIP))
 }
 
+func testAccServiceConfig_failOnRollback(rName string, broken bool) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "broken" {
+  family                   = "%[1]s-broken"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 256,
+    "essential": true,
+    "image": "%[1]s.invalid/does-not-exist:latest",
+    "memory": 512,
+    "name": "broken",
+    "networkMode": "awsvpc"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = %[2]t ? aws_ecs_task_definition.broken.arn : aws_ecs_task_definition.test.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = true
+  }
+
+  network_configuration {
+    security_groups  = aws_security_group.test[*].id
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state = true
+  fail_on_rollback      = true
+}
+`, rName, broken))
+}
+
 func testAccServiceConfig_launchTypeFargateAndPlatformVersion(rName, platformVersion string) string {
 	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
 resource "aws_ecs_service" "test" {
@@ -1889,6 +2361,27 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_launchTypeFargateAndWaitTimeout(rName string, desiredCount int, timeout string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = %[2]d
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_steady_state         = true
+  wait_for_steady_state_timeout = %[3]q
+}
+`, rName, desiredCount, timeout))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -3162,7 +3655,13 @@ resource "aws_ecs_service" "test" {
 `, rName))
 }
 
-func testAccServiceNetworkConfigurationConfig_base(rName, securityGroups string) string {
+func testAccServiceNetworkConfigurationConfig_base(rName, securityGroups, redeploymentStrategy string) string {
+	if redeploymentStrategy == "" {
+		redeploymentStrategy = "null"
+	} else {
+		redeploymentStrategy = strconv.Quote(redeploymentStrategy)
+	}
+
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_security_group" "test" {
   count = 2
@@ -3211,16 +3710,22 @@ resource "aws_ecs_service" "test" {
     security_groups = [%[2]s]
     subnets         = aws_subnet.test[*].id
   }
+
+  redeployment_strategy = %[3]s
 }
-`, rName, securityGroups))
+`, rName, securityGroups, redeploymentStrategy))
 }
 
 func testAccServiceConfig_networkConfiguration(rName string) string {
-	return testAccServiceNetworkConfigurationConfig_base(rName, "aws_security_group.test[0].id, aws_security_group.test[1].id")
+	return testAccServiceNetworkConfigurationConfig_base(rName, "aws_security_group.test[0].id, aws_security_group.test[1].id", "")
 }
 
 func testAccServiceConfig_networkConfigurationModified(rName string) string {
-	return testAccServiceNetworkConfigurationConfig_base(rName, "aws_security_group.test[0].id")
+	return testAccServiceNetworkConfigurationConfig_base(rName, "aws_security_group.test[0].id", "")
+}
+
+func testAccServiceConfig_networkConfigurationRedeploymentStrategy(rName, redeploymentStrategy string) string {
+	return testAccServiceNetworkConfigurationConfig_base(rName, "aws_security_group.test[0].id", redeploymentStrategy)
 }
 
 func testAccServiceConfig_registries(rName string) string {
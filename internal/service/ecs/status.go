@@ -20,9 +20,14 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
+
+	// Non-standard status for statusTasks()
+	tasksStatusNotStopped = "tfNOT_STOPPED"
 )
 
 func statusCapacityProvider(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
@@ -139,3 +144,31 @@ func statusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, clust
 		return output.TaskSets[0], aws.StringValue(output.TaskSets[0].Status), nil
 	}
 }
+
+func statusTasks(ctx context.Context, conn *ecs.ECS, cluster string, arns []*string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findTasksByARNs(ctx, conn, cluster, arns)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, tasksStatus(output, len(arns)), nil
+	}
+}
+
+// tasksStatus returns STOPPED if all of the expected number of tasks have stopped.
+// Newly started tasks may not be described yet, so missing tasks aren't considered stopped.
+func tasksStatus(tasks []*ecs.Task, count int) string {
+	if len(tasks) < count {
+		return tasksStatusNotStopped
+	}
+
+	for _, v := range tasks {
+		if aws.StringValue(v.LastStatus) != ecs.DesiredStatusStopped {
+			return tasksStatusNotStopped
+		}
+	}
+
+	return ecs.DesiredStatusStopped
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestTasksStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tasks []*ecs.Task
+		count int
+		want  string
+	}{
+		"all stopped": {
+			tasks: []*ecs.Task{
+				{LastStatus: aws.String(ecs.DesiredStatusStopped)},
+				{LastStatus: aws.String(ecs.DesiredStatusStopped)},
+			},
+			count: 2,
+			want:  ecs.DesiredStatusStopped,
+		},
+		"one running": {
+			tasks: []*ecs.Task{
+				{LastStatus: aws.String(ecs.DesiredStatusStopped)},
+				{LastStatus: aws.String(ecs.DesiredStatusRunning)},
+			},
+			count: 2,
+			want:  tasksStatusNotStopped,
+		},
+		"one provisioning": {
+			tasks: []*ecs.Task{
+				{LastStatus: aws.String("PROVISIONING")},
+			},
+			count: 1,
+			want:  tasksStatusNotStopped,
+		},
+		"missing task": {
+			tasks: []*ecs.Task{
+				{LastStatus: aws.String(ecs.DesiredStatusStopped)},
+			},
+			count: 2,
+			want:  tasksStatusNotStopped,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tasksStatus(testCase.tasks, testCase.count), testCase.want; got != want {
+				t.Errorf("tasksStatus() = %q, want %q", got, want)
+			}
+		})
+	}
+}
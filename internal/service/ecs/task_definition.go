@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -16,7 +17,11 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -26,6 +31,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -60,7 +66,12 @@ func ResourceTaskDefinition() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceTaskDefinitionContainerDefinitionsCustomizeDiff,
+			resourceTaskDefinitionVolumesCustomizeDiff,
+			resourceTaskDefinitionTrackLatestCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -103,6 +114,12 @@ func ResourceTaskDefinition() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"delete_on_destroy": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"skip_destroy"},
+			},
 			"ephemeral_storage": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -153,6 +170,12 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			"ignore_image_changes": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"track_latest"},
+			},
 			"ipc_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -266,9 +289,10 @@ func ResourceTaskDefinition() *schema.Resource {
 				},
 			},
 			"skip_destroy": {
-				Type:     schema.TypeBool,
-				Default:  false,
-				Optional: true,
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				ConflictsWith: []string{"delete_on_destroy"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -283,6 +307,10 @@ func ResourceTaskDefinition() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"verify_log_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"volume": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -529,6 +557,12 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 		input.Volumes = volumes
 	}
 
+	if d.Get("verify_log_groups").(bool) {
+		if err := verifyTaskDefinitionLogGroups(ctx, meta.(*conns.AWSClient), definitions); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+		}
+	}
+
 	output, err := conn.RegisterTaskDefinitionWithContext(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -684,17 +718,231 @@ func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	arn := d.Get(names.AttrARN).(string)
 
 	_, err := conn.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
-		TaskDefinition: aws.String(d.Get(names.AttrARN).(string)),
+		TaskDefinition: aws.String(arn),
 	})
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting ECS Task Definition (%s): %s", d.Id(), err)
 	}
 
+	// Only deregistered (INACTIVE) revisions can be deleted.
+	if d.Get("delete_on_destroy").(bool) {
+		output, err := conn.DeleteTaskDefinitionsWithContext(ctx, &ecs.DeleteTaskDefinitionsInput{
+			TaskDefinitions: aws.StringSlice([]string{arn}),
+		})
+
+		if err == nil && output != nil && len(output.Failures) > 0 {
+			err = taskDefinitionFailureError(output.Failures[0])
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting ECS Task Definition (%s) revision (%s): %s", d.Id(), arn, err)
+		}
+	}
+
 	return diags
 }
 
+func taskDefinitionFailureError(apiObject *ecs.Failure) error {
+	if v := aws.StringValue(apiObject.Detail); v != "" {
+		return fmt.Errorf("%s: %s", aws.StringValue(apiObject.Reason), v)
+	}
+
+	return errors.New(aws.StringValue(apiObject.Reason))
+}
+
+// resourceTaskDefinitionContainerDefinitionsCustomizeDiff validates the FireLens configuration of the containers when planning
+// so that misconfigured log routers are reported before tasks fail to start.
+func resourceTaskDefinitionContainerDefinitionsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("container_definitions") || !d.NewValueKnown("container_definitions") {
+		return nil
+	}
+
+	definitions, err := expandContainerDefinitions(d.Get("container_definitions").(string))
+
+	if err != nil {
+		return nil
+	}
+
+	if err := validFirelensConfiguration(definitions); err != nil {
+		return fmt.Errorf("container_definitions: %w", err)
+	}
+
+	return nil
+}
+
+// verifyTaskDefinitionLogGroups verifies that the CloudWatch Logs log groups that the containers log to with the awslogs
+// log driver exist, unless the containers create them. Tasks whose log groups don't exist fail to start.
+func verifyTaskDefinitionLogGroups(ctx context.Context, client *conns.AWSClient, definitions []*ecs.ContainerDefinition) error {
+	for _, v := range definitions {
+		if v.LogConfiguration == nil || aws.StringValue(v.LogConfiguration.LogDriver) != ecs.LogDriverAwslogs {
+			continue
+		}
+
+		options := aws.StringValueMap(v.LogConfiguration.Options)
+		logGroupName := options["awslogs-group"]
+
+		if logGroupName == "" || options["awslogs-create-group"] == "true" {
+			continue
+		}
+
+		// Log groups in other Regions can't be verified.
+		if region := options["awslogs-region"]; region != "" && region != client.Region {
+			continue
+		}
+
+		_, err := findLogGroupByName(ctx, client.LogsClient(ctx), logGroupName)
+
+		// Don't prevent creation when the caller isn't allowed to describe log groups.
+		if tfawserr_sdkv2.ErrCodeEquals(err, errCodeAccessDeniedException) {
+			log.Printf("[WARN] Unable to verify CloudWatch Logs Log Group (%s): %s", logGroupName, err)
+			continue
+		}
+
+		if tfresource.NotFound(err) {
+			return fmt.Errorf("container (%s) logs to CloudWatch Logs Log Group (%s), which doesn't exist. Create the log group or set the awslogs-create-group option to \"true\"", aws.StringValue(v.Name), logGroupName)
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading CloudWatch Logs Log Group (%s): %w", logGroupName, err)
+		}
+	}
+
+	return nil
+}
+
+// resourceTaskDefinitionTrackLatestCustomizeDiff suppresses the replacement of a task definition that tracks
+// the latest ACTIVE revision when the latest revision differs from the configuration only in container images,
+// e.g. because a new revision was registered by a deployment pipeline. This is opt-in as image changes made
+// in the configuration can't be told apart from those made outside of Terraform.
+func resourceTaskDefinitionTrackLatestCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("track_latest").(bool) || !d.Get("ignore_image_changes").(bool) {
+		return nil
+	}
+
+	if !d.HasChange("container_definitions") || !d.NewValueKnown("container_definitions") {
+		return nil
+	}
+
+	o, n := d.GetChange("container_definitions")
+	isAWSVPC := d.Get("network_mode").(string) == ecs.NetworkModeAwsvpc
+
+	if equal, err := containerDefinitionsAreEquivalentIgnoringImages(o.(string), n.(string), isAWSVPC); err != nil || !equal {
+		return nil
+	}
+
+	log.Printf("[DEBUG] ECS Task Definition (%s) latest revision (%d) differs only in container images, ignoring", d.Id(), d.Get("revision").(int))
+
+	return d.Clear("container_definitions")
+}
+
+// resourceTaskDefinitionVolumesCustomizeDiff validates EFS and FSx for Windows File Server volumes when planning
+// so that misconfigured volumes are reported before a task fails to mount them.
+func resourceTaskDefinitionVolumesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("volume") || !d.NewValueKnown("volume") {
+		return nil
+	}
+
+	for _, tfMapRaw := range d.Get("volume").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+
+		if v, ok := tfMap["efs_volume_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if err := validateTaskDefinitionEFSVolume(ctx, meta.(*conns.AWSClient), v[0].(map[string]interface{})); err != nil {
+				return fmt.Errorf("volume (%s) efs_volume_configuration: %w", name, err)
+			}
+		}
+
+		if v, ok := tfMap["fsx_windows_file_server_volume_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if err := validateTaskDefinitionFSxWindowsVolume(ctx, meta.(*conns.AWSClient), v[0].(map[string]interface{})); err != nil {
+				return fmt.Errorf("volume (%s) fsx_windows_file_server_volume_configuration: %w", name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateTaskDefinitionEFSVolume(ctx context.Context, client *conns.AWSClient, tfMap map[string]interface{}) error {
+	var accessPointID, iam string
+
+	if v, ok := tfMap["authorization_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		authConfig := v[0].(map[string]interface{})
+		accessPointID = authConfig["access_point_id"].(string)
+		iam = authConfig["iam"].(string)
+	}
+
+	fileSystemID := tfMap[names.AttrFileSystemID].(string)
+
+	if err := validEFSVolumeConfiguration(tfMap["transit_encryption"].(string), tfMap["root_directory"].(string), accessPointID, iam); err != nil {
+		return err
+	}
+
+	// Either no access point is used or its ID isn't known until the access point is created.
+	if accessPointID == "" {
+		return nil
+	}
+
+	accessPoint, err := findEFSAccessPointByID(ctx, client.EFSConn(ctx), accessPointID)
+
+	// Don't prevent planning when the caller isn't allowed to describe access points.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+		log.Printf("[WARN] Unable to validate EFS Access Point (%s): %s", accessPointID, err)
+		return nil
+	}
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("EFS Access Point (%s) not found", accessPointID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EFS Access Point (%s): %w", accessPointID, err)
+	}
+
+	if v := aws.StringValue(accessPoint.FileSystemId); v != fileSystemID {
+		return fmt.Errorf("EFS Access Point (%s) belongs to EFS File System (%s), not %s", accessPointID, v, fileSystemID)
+	}
+
+	return nil
+}
+
+func validateTaskDefinitionFSxWindowsVolume(ctx context.Context, client *conns.AWSClient, tfMap map[string]interface{}) error {
+	fileSystemID := tfMap[names.AttrFileSystemID].(string)
+
+	// The file system ID isn't known until the file system is created.
+	if fileSystemID == "" {
+		return nil
+	}
+
+	fileSystem, err := findFSxFileSystemByID(ctx, client.FSxConn(ctx), fileSystemID)
+
+	// Don't prevent planning when the caller isn't allowed to describe file systems.
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDeniedException) {
+		log.Printf("[WARN] Unable to validate FSx File System (%s): %s", fileSystemID, err)
+		return nil
+	}
+
+	if tfresource.NotFound(err) {
+		return fmt.Errorf("FSx File System (%s) not found", fileSystemID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading FSx File System (%s): %w", fileSystemID, err)
+	}
+
+	if v := aws.StringValue(fileSystem.FileSystemType); v != fsx.FileSystemTypeWindows {
+		return fmt.Errorf("FSx File System (%s) is of type %s, not %s", fileSystemID, v, fsx.FileSystemTypeWindows)
+	}
+
+	return nil
+}
+
 func resourceTaskDefinitionVolumeHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -56,6 +56,40 @@ func ContainerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	return equal, nil
 }
 
+// containerDefinitionsAreEquivalentIgnoringImages determines equality between two ECS container definition JSON strings
+// ignoring the containers' images, which are typically updated by deployments outside of Terraform.
+func containerDefinitionsAreEquivalentIgnoringImages(def1, def2 string, isAWSVPC bool) (bool, error) {
+	def1, err := removeContainerDefinitionsImages(def1)
+	if err != nil {
+		return false, err
+	}
+
+	def2, err = removeContainerDefinitionsImages(def2)
+	if err != nil {
+		return false, err
+	}
+
+	return ContainerDefinitionsAreEquivalent(def1, def2, isAWSVPC)
+}
+
+func removeContainerDefinitionsImages(def string) (string, error) {
+	var obj containerDefinitions
+	if err := json.Unmarshal([]byte(def), &obj); err != nil {
+		return "", err
+	}
+
+	for _, v := range obj {
+		v.Image = nil
+	}
+
+	b, err := jsonutil.BuildJSON(obj)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
 type containerDefinitions []*ecs.ContainerDefinition
 
 func (cd containerDefinitions) Reduce(isAWSVPC bool) error {
@@ -578,3 +578,61 @@ func TestContainerDefinitionsAreEquivalent_missingEnvironmentName(t *testing.T)
 		t.Fatal("Expected definitions to be equal.")
 	}
 }
+
+func TestContainerDefinitionsAreEquivalentIgnoringImages(t *testing.T) {
+	t.Parallel()
+
+	const cfgRepresentation = `
+[
+  {
+    "name": "app",
+    "image": "example.com/app:1.0.0",
+    "essential": true,
+    "memory": 128
+  },
+  {
+    "name": "sidecar",
+    "image": "example.com/sidecar:1.0.0",
+    "essential": false,
+    "memory": 64
+  }
+]`
+
+	testCases := map[string]struct {
+		apiRepresentation string
+		want              bool
+	}{
+		"same images": {
+			apiRepresentation: `[{"name":"app","image":"example.com/app:1.0.0","essential":true,"memory":128},{"name":"sidecar","image":"example.com/sidecar:1.0.0","essential":false,"memory":64}]`,
+			want:              true,
+		},
+		"different images": {
+			apiRepresentation: `[{"name":"sidecar","image":"example.com/sidecar:1.0.1","essential":false,"memory":64},{"name":"app","image":"example.com/app:2.0.0","essential":true,"memory":128}]`,
+			want:              true,
+		},
+		"different images and memory": {
+			apiRepresentation: `[{"name":"app","image":"example.com/app:2.0.0","essential":true,"memory":256},{"name":"sidecar","image":"example.com/sidecar:1.0.0","essential":false,"memory":64}]`,
+		},
+		"additional container": {
+			apiRepresentation: `[{"name":"app","image":"example.com/app:2.0.0","essential":true,"memory":128},{"name":"sidecar","image":"example.com/sidecar:1.0.0","essential":false,"memory":64},{"name":"proxy","image":"example.com/proxy:1.0.0","essential":false,"memory":64}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfecs.ContainerDefinitionsAreEquivalentIgnoringImages(cfgRepresentation, testCase.apiRepresentation, false)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("ContainerDefinitionsAreEquivalentIgnoringImages() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
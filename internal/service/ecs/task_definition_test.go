@@ -6,14 +6,17 @@ package ecs_test
 import (
 	"context"
 	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -101,7 +104,7 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -131,7 +134,7 @@ func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -174,7 +177,7 @@ func TestAccECSTaskDefinition_DockerVolume_basic(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -209,7 +212,7 @@ func TestAccECSTaskDefinition_DockerVolume_minimal(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -243,7 +246,7 @@ func TestAccECSTaskDefinition_runtimePlatform(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -277,7 +280,7 @@ func TestAccECSTaskDefinition_Fargate_runtimePlatform(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -310,7 +313,7 @@ func TestAccECSTaskDefinition_Fargate_runtimePlatformWithoutArch(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -345,7 +348,7 @@ func TestAccECSTaskDefinition_EFSVolume_minimal(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -381,7 +384,7 @@ func TestAccECSTaskDefinition_EFSVolume_basic(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -419,7 +422,7 @@ func TestAccECSTaskDefinition_EFSVolume_transitEncryptionMinimal(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -457,7 +460,7 @@ func TestAccECSTaskDefinition_EFSVolume_transitEncryption(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -494,7 +497,7 @@ func TestAccECSTaskDefinition_EFSVolume_transitEncryptionDisabled(t *testing.T)
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -535,7 +538,33 @@ func TestAccECSTaskDefinition_EFSVolume_accessPoint(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_EFSVolume_validation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_efsVolumeValidation(rName, "DISABLED", "", "ENABLED"),
+				ExpectError: regexache.MustCompile(`transit_encryption must be "ENABLED" when authorization_config.iam is "ENABLED"`),
+			},
+			{
+				Config:      testAccTaskDefinitionConfig_efsVolumeValidation(rName, "DISABLED", "fsap-0123456789abcdef0", "DISABLED"),
+				ExpectError: regexache.MustCompile(`transit_encryption must be "ENABLED" when authorization_config.access_point_id is set`),
+			},
+			{
+				Config:      testAccTaskDefinitionConfig_efsVolumeValidation(rName, "ENABLED", "fsap-0123456789abcdef0", "ENABLED"),
+				ExpectError: regexache.MustCompile(`EFS Access Point \(fsap-0123456789abcdef0\) not found`),
 			},
 		},
 	})
@@ -583,7 +612,7 @@ func TestAccECSTaskDefinition_fsxWinFileSystem(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -646,7 +675,7 @@ func TestAccECSTaskDefinition_service(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -675,7 +704,7 @@ func TestAccECSTaskDefinition_taskRoleARN(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -705,7 +734,7 @@ func TestAccECSTaskDefinition_networkMode(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -735,7 +764,7 @@ func TestAccECSTaskDefinition_ipcMode(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -765,7 +794,7 @@ func TestAccECSTaskDefinition_pidMode(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -796,7 +825,7 @@ func TestAccECSTaskDefinition_constraint(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -832,7 +861,7 @@ func TestAccECSTaskDefinition_changeVolumesForcesNewResource(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -862,7 +891,7 @@ func TestAccECSTaskDefinition_arrays(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -894,7 +923,7 @@ func TestAccECSTaskDefinition_Fargate_basic(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 			{
 				ExpectNonEmptyPlan: false,
@@ -933,7 +962,7 @@ func TestAccECSTaskDefinition_Fargate_ephemeralStorage(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -962,7 +991,7 @@ func TestAccECSTaskDefinition_executionRole(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -1022,7 +1051,7 @@ func TestAccECSTaskDefinition_tags(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 			{
 				Config: testAccTaskDefinitionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
@@ -1078,7 +1107,7 @@ func TestAccECSTaskDefinition_proxy(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -1108,7 +1137,7 @@ func TestAccECSTaskDefinition_inferenceAccelerator(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
 			},
 		},
 	})
@@ -1132,6 +1161,44 @@ func TestAccECSTaskDefinition_invalidContainerDefinition(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_deleteOnDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def1, def2 ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_deleteOnDestroy(rName, "nginx:1.25"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def1),
+					resource.TestCheckResourceAttr(resourceName, "delete_on_destroy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "skip_destroy", "false"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_deleteOnDestroy(rName, "nginx:1.26"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def2),
+					testAccCheckTaskDefinitionRevisionDeleted(ctx, &def1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
+			},
+		},
+	})
+}
+
 func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 	ctx := acctest.Context(t)
 	var def ecs.TaskDefinition
@@ -1145,7 +1212,7 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTaskDefinitionConfig_trackLatest(rName),
+				Config: testAccTaskDefinitionConfig_trackLatest(rName, false),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "ecs", regexache.MustCompile(`task-definition/.+`)),
@@ -1158,7 +1225,131 @@ func TestAccECSTaskDefinition_trackLatest(t *testing.T) {
 				ImportState:             true,
 				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"skip_destroy", "track_latest"},
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_ignoreImageChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_trackLatest(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "ignore_image_changes", "true"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccRegisterTaskDefinitionRevisionWithImages(ctx, t, &def, map[string]string{"jenkins": "jenkins:lts"})
+				},
+				Config: testAccTaskDefinitionConfig_trackLatest(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+// testAccRegisterTaskDefinitionRevisionWithImages registers a new revision of the task definition, outside of Terraform,
+// with the specified container images and deregisters the existing revision.
+func testAccRegisterTaskDefinitionRevisionWithImages(ctx context.Context, t *testing.T, def *ecs.TaskDefinition, images map[string]string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+	for _, v := range def.ContainerDefinitions {
+		if image, ok := images[aws.StringValue(v.Name)]; ok {
+			v.Image = aws.String(image)
+		}
+	}
+
+	_, err := conn.RegisterTaskDefinitionWithContext(ctx, &ecs.RegisterTaskDefinitionInput{
+		ContainerDefinitions: def.ContainerDefinitions,
+		Family:               def.Family,
+		NetworkMode:          def.NetworkMode,
+		Volumes:              def.Volumes,
+	})
+
+	if err != nil {
+		t.Fatalf("registering ECS Task Definition (%s) revision: %s", aws.StringValue(def.Family), err)
+	}
+
+	_, err = conn.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: def.TaskDefinitionArn,
+	})
+
+	if err != nil {
+		t.Fatalf("deregistering ECS Task Definition (%s): %s", aws.StringValue(def.TaskDefinitionArn), err)
+	}
+}
+
+func TestAccECSTaskDefinition_firelensValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_firelensNoLogRouter(rName),
+				ExpectError: regexache.MustCompile(`use the awsfirelens log driver, which requires a container with a firelensConfiguration`),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_verifyLogGroups(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_verifyLogGroups(rName, false),
+				ExpectError: regexache.MustCompile(`logs to CloudWatch Logs Log Group \(.+\), which doesn't exist`),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_verifyLogGroups(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "verify_log_groups", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_on_destroy", "ignore_image_changes", "skip_destroy", "track_latest", "verify_log_groups"},
 			},
 		},
 	})
@@ -1322,7 +1513,7 @@ func testAccCheckTaskDefinitionDestroy(ctx context.Context) resource.TestCheckFu
 				return err
 			}
 
-			if out.TaskDefinition != nil && *out.TaskDefinition.Status != ecs.TaskDefinitionStatusInactive {
+			if out.TaskDefinition != nil && !slices.Contains([]string{ecs.TaskDefinitionStatusInactive, ecs.TaskDefinitionStatusDeleteInProgress}, aws.StringValue(out.TaskDefinition.Status)) {
 				return fmt.Errorf("ECS task definition still exists:\n%#v", *out.TaskDefinition)
 			}
 		}
@@ -1331,6 +1522,32 @@ func testAccCheckTaskDefinitionDestroy(ctx context.Context) resource.TestCheckFu
 	}
 }
 
+// testAccCheckTaskDefinitionRevisionDeleted checks that the specified revision has been deleted rather than only deregistered.
+func testAccCheckTaskDefinitionRevisionDeleted(ctx context.Context, def *ecs.TaskDefinition) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ECSConn(ctx)
+
+		out, err := conn.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+			TaskDefinition: def.TaskDefinitionArn,
+		})
+
+		// Revisions that have been deleted can no longer be described.
+		if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClientException) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(out.TaskDefinition.Status); status != ecs.TaskDefinitionStatusDeleteInProgress {
+			return fmt.Errorf("ECS Task Definition (%s) status is %s, expected %s", aws.StringValue(def.TaskDefinitionArn), status, ecs.TaskDefinitionStatusDeleteInProgress)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTaskDefinitionExists(ctx context.Context, name string, def *ecs.TaskDefinition) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -2197,6 +2414,41 @@ TASK_DEFINITION
 `, rName, useIam)
 }
 
+func testAccTaskDefinitionConfig_efsVolumeValidation(rName, transitEncryption, accessPointID, iam string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "name": "sleep",
+    "image": "busybox",
+    "cpu": 10,
+    "command": ["sleep","360"],
+    "memory": 10,
+    "essential": true
+  }
+]
+TASK_DEFINITION
+
+  volume {
+    name = %[1]q
+
+    efs_volume_configuration {
+      file_system_id     = "fs-0123456789abcdef0"
+      transit_encryption = %[2]q
+
+      authorization_config {
+        access_point_id = %[3]q
+        iam             = %[4]q
+      }
+    }
+  }
+}
+`, rName, transitEncryption, accessPointID, iam)
+}
+
 func testAccTaskDefinitionConfig_roleARN(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -2896,7 +3148,62 @@ TASK_DEFINITION
 `, rName)
 }
 
-func testAccTaskDefinitionConfig_trackLatest(rName string) string {
+func testAccTaskDefinitionConfig_firelensNoLogRouter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    name      = "app"
+    image     = "nginx"
+    memory    = 128
+    essential = true
+    logConfiguration = {
+      logDriver = "awsfirelens"
+      options = {
+        Name = "cloudwatch"
+      }
+    }
+  }])
+}
+`, rName)
+}
+
+func testAccTaskDefinitionConfig_verifyLogGroups(rName string, logGroup bool) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_cloudwatch_log_group" "test" {
+  count = %[2]t ? 1 : 0
+
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family            = %[1]q
+  verify_log_groups = true
+
+  container_definitions = jsonencode([{
+    name      = "app"
+    image     = "nginx"
+    memory    = 128
+    essential = true
+    logConfiguration = {
+      logDriver = "awslogs"
+      options = {
+        awslogs-group         = %[1]q
+        awslogs-region        = data.aws_region.current.name
+        awslogs-stream-prefix = "app"
+      }
+    }
+  }])
+
+  depends_on = [aws_cloudwatch_log_group.test]
+}
+`, rName, logGroup)
+}
+
+func testAccTaskDefinitionConfig_trackLatest(rName string, ignoreImageChanges bool) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_task_definition" "test" {
   family = %[1]q
@@ -2945,7 +3252,27 @@ TASK_DEFINITION
     host_path = "/ecs/jenkins-home"
   }
 
-  track_latest = true
+  track_latest         = true
+  ignore_image_changes = %[2]t
 }
-`, rName)
+`, rName, ignoreImageChanges)
+}
+
+func testAccTaskDefinitionConfig_deleteOnDestroy(rName, image string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family            = %[1]q
+  delete_on_destroy = true
+
+  container_definitions = jsonencode([
+    {
+      name      = "web"
+      image     = %[2]q
+      cpu       = 10
+      memory    = 128
+      essential = true
+    }
+  ])
+}
+`, rName, image)
 }
@@ -6,6 +6,7 @@ package ecs
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -25,6 +26,10 @@ func DataSourceTaskExecution() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceTaskExecutionRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"capacity_provider_strategy": {
 				Type:     schema.TypeSet,
@@ -269,6 +274,63 @@ func DataSourceTaskExecution() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"tasks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"containers": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exit_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"has_exit_code": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"last_status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"last_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stop_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stopped_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"task_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"wait_until_stopped": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -364,9 +426,69 @@ func dataSourceTaskExecutionRead(ctx context.Context, d *schema.ResourceData, me
 	}
 	d.Set("task_arns", flex.FlattenStringList(taskArns))
 
+	tasks := out.Tasks
+	if d.Get("wait_until_stopped").(bool) {
+		tasks, err = waitTasksStopped(ctx, conn, cluster, taskArns, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			return create.AppendDiagError(diags, names.ECS, create.ErrActionWaitingForCreation, DSNameTaskExecution, d.Id(), err)
+		}
+	}
+
+	if err := d.Set("tasks", flattenTaskExecutionTasks(tasks, taskArns)); err != nil {
+		return create.AppendDiagSettingError(diags, names.ECS, DSNameTaskExecution, d.Id(), "tasks", err)
+	}
+
 	return diags
 }
 
+// flattenTaskExecutionTasks flattens the tasks in the order of their ARNs.
+func flattenTaskExecutionTasks(apiObjects []*ecs.Task, arns []*string) []interface{} {
+	tasks := make(map[string]*ecs.Task, len(apiObjects))
+	for _, v := range apiObjects {
+		if v != nil {
+			tasks[aws.StringValue(v.TaskArn)] = v
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, arn := range arns {
+		apiObject, ok := tasks[aws.StringValue(arn)]
+		if !ok {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"containers":     flattenTaskExecutionContainers(apiObject.Containers),
+			"last_status":    aws.StringValue(apiObject.LastStatus),
+			"stop_code":      aws.StringValue(apiObject.StopCode),
+			"stopped_reason": aws.StringValue(apiObject.StoppedReason),
+			"task_arn":       aws.StringValue(apiObject.TaskArn),
+		})
+	}
+
+	return tfList
+}
+
+func flattenTaskExecutionContainers(apiObjects []*ecs.Container) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"exit_code":     int(aws.Int64Value(apiObject.ExitCode)),
+			"has_exit_code": apiObject.ExitCode != nil,
+			"last_status":   aws.StringValue(apiObject.LastStatus),
+			names.AttrName:  aws.StringValue(apiObject.Name),
+			"reason":        aws.StringValue(apiObject.Reason),
+		})
+	}
+
+	return tfList
+}
+
 func expandTaskOverride(tfList []interface{}) *ecs.TaskOverride {
 	if len(tfList) == 0 {
 		return nil
@@ -124,6 +124,44 @@ func TestAccECSTaskExecutionDataSource_tags(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskExecutionDataSource_waitUntilStopped(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ecs_task_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, ecs.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskExecutionDataSourceConfig_waitUntilStopped(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "wait_until_stopped", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "task_arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tasks.0.task_arn", dataSourceName, "task_arns.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.last_status", ecs.DesiredStatusStopped),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tasks.0.stop_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "tasks.0.stopped_reason"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.exit_code", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.has_exit_code", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.name", "sleep"),
+					resource.TestCheckResourceAttr(dataSourceName, "tasks.0.containers.0.last_status", ecs.DesiredStatusStopped),
+				),
+			},
+		},
+	})
+}
+
 func testAccTaskExecutionDataSourceConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
@@ -261,3 +299,26 @@ data "aws_ecs_task_execution" "test" {
 }
 `, envKey1, envValue1))
 }
+
+func testAccTaskExecutionDataSourceConfig_waitUntilStopped(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		testAccTaskExecutionDataSourceConfig_base(rName),
+		`
+data "aws_ecs_task_execution" "test" {
+  depends_on = [aws_ecs_cluster_capacity_providers.test]
+
+  cluster            = aws_ecs_cluster.test.id
+  task_definition    = aws_ecs_task_definition.test.arn
+  desired_count      = 1
+  launch_type        = "FARGATE"
+  wait_until_stopped = true
+
+  network_configuration {
+    subnets          = aws_subnet.test[*].id
+    security_groups  = [aws_security_group.test.id]
+    assign_public_ip = false
+  }
+}
+`)
+}
@@ -5,8 +5,12 @@ package ecs
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -62,3 +66,83 @@ func validPlacementStrategy(stratType, stratField string) error {
 	}
 	return nil
 }
+
+// Validates that the settings of an EFS volume configuration are compatible with each other.
+// Using IAM authorization or an access point requires transit encryption, and a volume that uses
+// an access point must use the access point's root directory.
+func validEFSVolumeConfiguration(transitEncryption, rootDirectory, accessPointID, iam string) error {
+	if iam == ecs.EFSAuthorizationConfigIAMEnabled && transitEncryption != ecs.EFSTransitEncryptionEnabled {
+		return fmt.Errorf("transit_encryption must be %q when authorization_config.iam is %q", ecs.EFSTransitEncryptionEnabled, iam)
+	}
+
+	if accessPointID != "" {
+		if transitEncryption != ecs.EFSTransitEncryptionEnabled {
+			return fmt.Errorf("transit_encryption must be %q when authorization_config.access_point_id is set", ecs.EFSTransitEncryptionEnabled)
+		}
+
+		if rootDirectory != "" && rootDirectory != "/" {
+			return fmt.Errorf("root_directory must be omitted or \"/\" when authorization_config.access_point_id is set, got: %s", rootDirectory)
+		}
+	}
+
+	return nil
+}
+
+// Validates the FireLens configuration of a task definition's containers.
+// Containers that use the awsfirelens log driver require a single log router container, and the
+// log router's config-file-type and config-file-value options must be set together.
+// Only the values of known options are validated, so that options added to ECS later aren't rejected.
+func validFirelensConfiguration(definitions []*ecs.ContainerDefinition) error {
+	var logRouters, firelensLogDriverContainers []string
+
+	for _, v := range definitions {
+		name := aws.StringValue(v.Name)
+
+		if v.LogConfiguration != nil && aws.StringValue(v.LogConfiguration.LogDriver) == ecs.LogDriverAwsfirelens {
+			firelensLogDriverContainers = append(firelensLogDriverContainers, name)
+		}
+
+		if v.FirelensConfiguration == nil {
+			continue
+		}
+
+		logRouters = append(logRouters, name)
+
+		if t := aws.StringValue(v.FirelensConfiguration.Type); !slices.Contains(ecs.FirelensConfigurationType_Values(), t) {
+			return fmt.Errorf("container (%s) firelensConfiguration.type must be one of %v, got: %s", name, ecs.FirelensConfigurationType_Values(), t)
+		}
+
+		options := aws.StringValueMap(v.FirelensConfiguration.Options)
+
+		for k, v := range options {
+			switch k {
+			case "config-file-type":
+				if v != "file" && v != "s3" {
+					return fmt.Errorf("container (%s) firelensConfiguration.options.config-file-type must be \"file\" or \"s3\", got: %s", name, v)
+				}
+			case "config-file-value":
+			case "enable-ecs-log-metadata":
+				if v != "true" && v != "false" {
+					return fmt.Errorf("container (%s) firelensConfiguration.options.enable-ecs-log-metadata must be \"true\" or \"false\", got: %s", name, v)
+				}
+			}
+		}
+
+		_, hasType := options["config-file-type"]
+		_, hasValue := options["config-file-value"]
+
+		if hasType != hasValue {
+			return fmt.Errorf("container (%s) firelensConfiguration.options config-file-type and config-file-value must be set together", name)
+		}
+	}
+
+	if len(logRouters) > 1 {
+		return fmt.Errorf("only one container can have a firelensConfiguration, got: %s", strings.Join(logRouters, ", "))
+	}
+
+	if len(firelensLogDriverContainers) > 0 && len(logRouters) == 0 {
+		return fmt.Errorf("containers (%s) use the %s log driver, which requires a container with a firelensConfiguration", strings.Join(firelensLogDriverContainers, ", "), ecs.LogDriverAwsfirelens)
+	}
+
+	return nil
+}
@@ -94,3 +94,138 @@ func TestValidPlacementStrategy(t *testing.T) {
 		}
 	}
 }
+
+func TestValidEFSVolumeConfiguration(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		transitEncryption string
+		rootDirectory     string
+		accessPointID     string
+		iam               string
+		Err               bool
+	}{
+		{
+			rootDirectory: "/home/test",
+			Err:           false,
+		},
+		{
+			transitEncryption: "ENABLED",
+			iam:               "ENABLED",
+			Err:               false,
+		},
+		{
+			transitEncryption: "DISABLED",
+			iam:               "ENABLED",
+			Err:               true,
+		},
+		{
+			iam: "ENABLED",
+			Err: true,
+		},
+		{
+			transitEncryption: "ENABLED",
+			rootDirectory:     "/",
+			accessPointID:     "fsap-0123456789abcdef0",
+			Err:               false,
+		},
+		{
+			transitEncryption: "DISABLED",
+			rootDirectory:     "/",
+			accessPointID:     "fsap-0123456789abcdef0",
+			Err:               true,
+		},
+		{
+			transitEncryption: "ENABLED",
+			rootDirectory:     "/home/test",
+			accessPointID:     "fsap-0123456789abcdef0",
+			Err:               true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validEFSVolumeConfiguration(tc.transitEncryption, tc.rootDirectory, tc.accessPointID, tc.iam)
+
+		if err != nil && !tc.Err {
+			t.Fatalf("Unexpected validation error for %+v: %s", tc, err)
+		}
+
+		if err == nil && tc.Err {
+			t.Fatalf("Expected validation error for %+v", tc)
+		}
+	}
+}
+
+func TestValidFirelensConfiguration(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		definitions string
+		Err         bool
+	}{
+		{
+			definitions: `[{"name": "app"}]`,
+			Err:         false,
+		},
+		{
+			definitions: `[
+  {"name": "log_router", "firelensConfiguration": {"type": "fluentbit", "options": {"enable-ecs-log-metadata": "true"}}},
+  {"name": "app", "logConfiguration": {"logDriver": "awsfirelens"}}
+]`,
+			Err: false,
+		},
+		{
+			definitions: `[{"name": "log_router", "firelensConfiguration": {"type": "fluentd", "options": {"config-file-type": "s3", "config-file-value": "arn:aws:s3:::example/fluent.conf"}}}]`,
+			Err:         false,
+		},
+		{
+			definitions: `[{"name": "log_router", "firelensConfiguration": {"type": "logstash"}}]`,
+			Err:         true,
+		},
+		{
+			definitions: `[{"name": "log_router", "firelensConfiguration": {"type": "fluentbit", "options": {"unknown": "value"}}}]`,
+			Err:         false,
+		},
+		{
+			definitions: `[{"name": "log_router", "firelensConfiguration": {"type": "fluentbit", "options": {"config-file-type": "http", "config-file-value": "/fluent.conf"}}}]`,
+			Err:         true,
+		},
+		{
+			definitions: `[{"name": "log_router", "firelensConfiguration": {"type": "fluentbit", "options": {"config-file-type": "file"}}}]`,
+			Err:         true,
+		},
+		{
+			definitions: `[{"name": "log_router", "firelensConfiguration": {"type": "fluentbit", "options": {"enable-ecs-log-metadata": "yes"}}}]`,
+			Err:         true,
+		},
+		{
+			definitions: `[
+  {"name": "log_router1", "firelensConfiguration": {"type": "fluentbit"}},
+  {"name": "log_router2", "firelensConfiguration": {"type": "fluentbit"}}
+]`,
+			Err: true,
+		},
+		{
+			definitions: `[{"name": "app", "logConfiguration": {"logDriver": "awsfirelens"}}]`,
+			Err:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		definitions, err := expandContainerDefinitions(tc.definitions)
+
+		if err != nil {
+			t.Fatalf("Unexpected error expanding %s: %s", tc.definitions, err)
+		}
+
+		err = validFirelensConfiguration(definitions)
+
+		if err != nil && !tc.Err {
+			t.Fatalf("Unexpected validation error for %s: %s", tc.definitions, err)
+		}
+
+		if err == nil && tc.Err {
+			t.Fatalf("Expected validation error for %s", tc.definitions)
+		}
+	}
+}
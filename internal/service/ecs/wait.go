@@ -5,6 +5,9 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -29,6 +32,9 @@ const (
 
 	taskSetCreateTimeout = 10 * time.Minute
 	taskSetDeleteTimeout = 10 * time.Minute
+
+	tasksStoppedDelay      = 10 * time.Second
+	tasksStoppedMinTimeout = 5 * time.Second
 )
 
 func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
@@ -75,10 +81,22 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 		input.Cluster = aws.String(cluster)
 	}
 
+	deadline := time.Now().Add(timeout)
+	refresh := statusServiceWaitForStable(ctx, conn, id, cluster)
+
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if service, ok := outputRaw.(*ecs.Service); ok && err == nil && status != serviceStatusStable {
+				log.Printf("[INFO] Waiting for ECS Service (%s) to reach steady state: %d deployments, %d/%d tasks running, %s remaining",
+					id, len(service.Deployments), aws.Int64Value(service.RunningCount), aws.Int64Value(service.DesiredCount), time.Until(deadline).Round(time.Second))
+			}
+
+			return outputRaw, status, err
+		},
 		Timeout: timeout,
 	}
 
@@ -91,6 +109,125 @@ func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, t
 	return nil, err
 }
 
+// waitServiceStableWithoutRollback waits for an ECS Service to reach a steady state, like waitServiceStable,
+// but fails if the service's primary deployment at the start of the wait fails or is rolled back,
+// e.g. by the deployment circuit breaker. Does not return tags.
+func waitServiceStableWithoutRollback(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
+	service, err := FindServiceNoTagsByID(ctx, conn, id, cluster)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var deployment *ecs.Deployment
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			deployment = v
+			break
+		}
+	}
+
+	if deployment == nil {
+		return waitServiceStable(ctx, conn, id, cluster, timeout)
+	}
+
+	refresh := statusServiceWaitForStable(ctx, conn, id, cluster)
+
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
+		Target:  []string{serviceStatusStable},
+		Refresh: func() (interface{}, string, error) {
+			outputRaw, status, err := refresh()
+
+			if service, ok := outputRaw.(*ecs.Service); ok && err == nil {
+				if err := serviceDeploymentRollbackError(service, deployment); err != nil {
+					return service, status, err
+				}
+
+				if status != serviceStatusStable {
+					log.Printf("[INFO] Waiting for ECS Service (%s) deployment (%s) to complete: %s, %d/%d tasks running",
+						id, aws.StringValue(deployment.Id), serviceDeploymentRolloutState(service, deployment), aws.Int64Value(service.RunningCount), aws.Int64Value(service.DesiredCount))
+				}
+			}
+
+			return outputRaw, status, err
+		},
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		return v, err
+	}
+
+	return nil, err
+}
+
+// serviceDeploymentRolloutState returns the current rollout state of the specified deployment of the service.
+func serviceDeploymentRolloutState(service *ecs.Service, deployment *ecs.Deployment) string {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Id) == aws.StringValue(deployment.Id) {
+			return aws.StringValue(v.RolloutState)
+		}
+	}
+
+	return ""
+}
+
+// serviceDeploymentRollbackError returns an error if the specified deployment of the service has failed,
+// or is no longer one of the service's deployments because it has been rolled back.
+// The error includes the service events since the deployment started.
+func serviceDeploymentRollbackError(service *ecs.Service, deployment *ecs.Deployment) error {
+	const (
+		maxEvents = 5
+	)
+	var err error
+	found := false
+
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Id) != aws.StringValue(deployment.Id) {
+			continue
+		}
+
+		found = true
+
+		if aws.StringValue(v.RolloutState) == ecs.DeploymentRolloutStateFailed {
+			err = fmt.Errorf("deployment (%s) failed: %s", aws.StringValue(v.Id), aws.StringValue(v.RolloutStateReason))
+		}
+
+		break
+	}
+
+	if found && err == nil {
+		return nil
+	}
+
+	if !found {
+		err = fmt.Errorf("deployment (%s) was rolled back", aws.StringValue(deployment.Id))
+	}
+
+	// Service events are returned newest first.
+	var events []string
+	for _, v := range service.Events {
+		if len(events) == maxEvents {
+			break
+		}
+
+		if createdAt := deployment.CreatedAt; createdAt != nil && v.CreatedAt != nil && v.CreatedAt.Before(aws.TimeValue(createdAt)) {
+			break
+		}
+
+		events = append(events, fmt.Sprintf("%s: %s", aws.TimeValue(v.CreatedAt).Format(time.RFC3339), aws.StringValue(v.Message)))
+	}
+
+	if len(events) > 0 {
+		err = fmt.Errorf("%w\n\nMost recent service events:\n%s", err, strings.Join(events, "\n"))
+	}
+
+	return err
+}
+
 // waitServiceInactive waits for an ECS Service to reach the status "INACTIVE".
 func waitServiceInactive(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) error {
 	input := &ecs.DescribeServicesInput{
@@ -157,3 +294,22 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 
 	return err
 }
+
+func waitTasksStopped(ctx context.Context, conn *ecs.ECS, cluster string, arns []*string, timeout time.Duration) ([]*ecs.Task, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{tasksStatusNotStopped},
+		Target:     []string{ecs.DesiredStatusStopped},
+		Refresh:    statusTasks(ctx, conn, cluster, arns),
+		Timeout:    timeout,
+		Delay:      tasksStoppedDelay,
+		MinTimeout: tasksStoppedMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.([]*ecs.Task); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentRollbackError(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	deployment := &ecs.Deployment{
+		CreatedAt: aws.Time(createdAt),
+		Id:        aws.String("ecs-svc/1111111111111111111"),
+	}
+	events := []*ecs.ServiceEvent{
+		{
+			CreatedAt: aws.Time(createdAt.Add(2 * time.Minute)),
+			Message:   aws.String("(service example) rolling back to deployment ecs-svc/2222222222222222222."),
+		},
+		{
+			CreatedAt: aws.Time(createdAt.Add(1 * time.Minute)),
+			Message:   aws.String("(service example) deployment ecs-svc/1111111111111111111 deployment failed: tasks failed to start."),
+		},
+		{
+			CreatedAt: aws.Time(createdAt.Add(-1 * time.Hour)),
+			Message:   aws.String("(service example) has reached a steady state."),
+		},
+	}
+
+	testCases := map[string]struct {
+		service      *ecs.Service
+		wantErr      bool
+		wantContains []string
+		wantExcludes []string
+	}{
+		"in progress": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{{
+					Id:           deployment.Id,
+					RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+					Status:       aws.String(serviceDeploymentStatusPrimary),
+				}},
+				Events: events,
+			},
+		},
+		"completed": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{{
+					Id:           deployment.Id,
+					RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+					Status:       aws.String(serviceDeploymentStatusPrimary),
+				}},
+			},
+		},
+		"failed": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{
+						Id:           aws.String("ecs-svc/2222222222222222222"),
+						RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress),
+						Status:       aws.String(serviceDeploymentStatusPrimary),
+					},
+					{
+						Id:                 deployment.Id,
+						RolloutState:       aws.String(ecs.DeploymentRolloutStateFailed),
+						RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."),
+						Status:             aws.String("ACTIVE"),
+					},
+				},
+				Events: events,
+			},
+			wantErr: true,
+			wantContains: []string{
+				"deployment (ecs-svc/1111111111111111111) failed: ECS deployment circuit breaker: tasks failed to start.",
+				"rolling back to deployment ecs-svc/2222222222222222222",
+				"deployment failed: tasks failed to start",
+			},
+			wantExcludes: []string{
+				"has reached a steady state",
+			},
+		},
+		"rolled back": {
+			service: &ecs.Service{
+				Deployments: []*ecs.Deployment{{
+					Id:           aws.String("ecs-svc/2222222222222222222"),
+					RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted),
+					Status:       aws.String(serviceDeploymentStatusPrimary),
+				}},
+				Events: events,
+			},
+			wantErr: true,
+			wantContains: []string{
+				"deployment (ecs-svc/1111111111111111111) was rolled back",
+				"rolling back to deployment ecs-svc/2222222222222222222",
+			},
+			wantExcludes: []string{
+				"has reached a steady state",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := serviceDeploymentRollbackError(testCase.service, deployment)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("serviceDeploymentRollbackError() error = %v, want error %t", err, want)
+			}
+
+			if err == nil {
+				return
+			}
+
+			for _, v := range testCase.wantContains {
+				if !strings.Contains(err.Error(), v) {
+					t.Errorf("error %q does not contain %q", err, v)
+				}
+			}
+
+			for _, v := range testCase.wantExcludes {
+				if strings.Contains(err.Error(), v) {
+					t.Errorf("error %q contains %q", err, v)
+				}
+			}
+		})
+	}
+}
@@ -68,6 +68,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"minify": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
 				"override_json": {
 					Type:         schema.TypeString,
@@ -301,6 +306,10 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: merging override document %d: %s", overrideJSONIndex, err)
 			}
 
+			for _, sid := range mergedDoc.ConflictingEffectSids(overrideDoc) {
+				diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document: override document %d changes the Effect of the statement with Sid (%s)", overrideJSONIndex, sid)
+			}
+
 			mergedDoc.Merge(overrideDoc)
 		}
 	}
@@ -312,8 +321,6 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
-	d.Set("json", jsonString)
-
 	jsonMinDoc, err := json.Marshal(mergedDoc)
 	if err != nil {
 		// should never happen if the above code is correct
@@ -321,6 +328,12 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonMinString := string(jsonMinDoc)
 
+	// minify strips the whitespace from json to help keep policies under IAM's size limits.
+	if d.Get("minify").(bool) {
+		jsonString = jsonMinString
+	}
+
+	d.Set("json", jsonString)
 	d.Set("minified_json", jsonMinString)
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
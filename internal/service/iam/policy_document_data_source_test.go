@@ -235,6 +235,26 @@ func TestAccIAMPolicyDocumentDataSource_overrideList(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "json",
+						`{"Version":"2012-10-17","Statement":[{"Sid":"AllowRead","Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+					),
+					resource.TestCheckResourceAttrPair("data.aws_iam_policy_document.test", "json", "data.aws_iam_policy_document.test", "minified_json"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_noStatementMerge(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -1649,3 +1669,28 @@ data "aws_iam_policy_document" "partial" {
   }
 }
 `
+
+const testAccPolicyDocumentDataSourceConfig_minify = `
+data "aws_iam_policy_document" "source" {
+  statement {
+    sid       = "AllowRead"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "override" {
+  statement {
+    sid       = "AllowRead"
+    effect    = "Deny"
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+}
+
+data "aws_iam_policy_document" "test" {
+  minify                    = true
+  source_policy_documents   = [data.aws_iam_policy_document.source.json]
+  override_policy_documents = [data.aws_iam_policy_document.override.json]
+}
+`
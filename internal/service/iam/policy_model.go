@@ -82,6 +82,28 @@ func (s *IAMPolicyDoc) Merge(newDoc *IAMPolicyDoc) {
 	}
 }
 
+// ConflictingEffectSids returns the Sids of newDoc's statements that would override
+// a statement with the same Sid but a different Effect when newDoc is merged.
+func (s *IAMPolicyDoc) ConflictingEffectSids(newDoc *IAMPolicyDoc) []string {
+	var sids []string
+
+	for _, newStatement := range newDoc.Statements {
+		if len(newStatement.Sid) == 0 {
+			continue
+		}
+		for _, existingStatement := range s.Statements {
+			if existingStatement.Sid == newStatement.Sid {
+				if existingStatement.Effect != newStatement.Effect {
+					sids = append(sids, newStatement.Sid)
+				}
+				break
+			}
+		}
+	}
+
+	return sids
+}
+
 func (ps IAMPolicyStatementPrincipalSet) MarshalJSON() ([]byte, error) {
 	raw := map[string]interface{}{}
 
@@ -430,3 +430,67 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestIAMPolicyDocConflictingEffectSids(t *testing.T) { // nosemgrep:ci.iam-in-func-name
+	t.Parallel()
+
+	doc := &tfiam.IAMPolicyDoc{
+		Statements: []*tfiam.IAMPolicyStatement{
+			{Sid: "AllowRead", Effect: "Allow"},
+			{Sid: "DenyDelete", Effect: "Deny"},
+			{Effect: "Allow"},
+		},
+	}
+
+	testCases := map[string]struct {
+		newDoc *tfiam.IAMPolicyDoc
+		want   []string
+	}{
+		"no statements": {
+			newDoc: &tfiam.IAMPolicyDoc{},
+		},
+		"same effect": {
+			newDoc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "AllowRead", Effect: "Allow"},
+				},
+			},
+		},
+		"new sid": {
+			newDoc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "AllowWrite", Effect: "Deny"},
+				},
+			},
+		},
+		"no sid": {
+			newDoc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Effect: "Deny"},
+				},
+			},
+		},
+		"different effects": {
+			newDoc: &tfiam.IAMPolicyDoc{
+				Statements: []*tfiam.IAMPolicyStatement{
+					{Sid: "AllowRead", Effect: "Deny"},
+					{Sid: "AllowWrite", Effect: "Allow"},
+					{Sid: "DenyDelete", Effect: "Allow"},
+				},
+			},
+			want: []string{"AllowRead", "DenyDelete"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := doc.ConflictingEffectSids(testCase.newDoc); !reflect.DeepEqual(got, testCase.want) {
+				t.Errorf("ConflictingEffectSids() = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
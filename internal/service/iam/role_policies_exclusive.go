@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_iam_role_policies_exclusive", name="Role Policies Exclusive")
+func resourceRolePoliciesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		ReadWithoutTimeout:   resourceRolePoliciesExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePoliciesExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("role_name", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_names": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleName := d.Get("role_name").(string)
+	policyNames, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	// Inline policies are created by aws_iam_role_policy or aws_iam_role. Only remove the policies that aren't configured here.
+	if v := unconfiguredRoleItems(policyNames, flex.ExpandStringValueSet(d.Get("policy_names").(*schema.Set))); len(v) > 0 {
+		log.Printf("[DEBUG] Deleting IAM Role (%s) inline policies not managed by Terraform: %v", roleName, v)
+		if err := deleteRoleInlinePolicies(ctx, conn, roleName, v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IAM Role (%s) inline policies: %s", roleName, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRolePoliciesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePoliciesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	policyNames, err := findRolePolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing IAM Role Policies Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", d.Id(), err)
+	}
+
+	d.Set("policy_names", policyNames)
+	d.Set("role_name", d.Id())
+
+	return diags
+}
+
+// unconfiguredRoleItems returns the items attached to a role that aren't in the configured items.
+func unconfiguredRoleItems(attached, configured []string) []string {
+	m := make(map[string]struct{}, len(configured))
+	for _, v := range configured {
+		m[v] = struct{}{}
+	}
+
+	var output []string
+
+	for _, v := range attached {
+		if _, ok := m[v]; !ok {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
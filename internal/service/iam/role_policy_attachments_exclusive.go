@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+func resourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("role_name", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleName := d.Get("role_name").(string)
+	policyARNs, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) policy attachments: %s", roleName, err)
+	}
+
+	// Policies are attached by aws_iam_role_policy_attachment or aws_iam_role. Only detach the policies that aren't configured here.
+	if v := unconfiguredRoleItems(policyARNs, flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))); len(v) > 0 {
+		log.Printf("[DEBUG] Detaching IAM Role (%s) policies not managed by Terraform: %v", roleName, v)
+		if err := deleteRolePolicyAttachments(ctx, conn, roleName, v); err != nil {
+			return sdkdiag.AppendErrorf(diags, "detaching IAM Role (%s) policies: %s", roleName, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(roleName)
+	}
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	policyARNs, err := findRoleAttachedPolicies(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role (%s) not found, removing IAM Role Policy Attachments Exclusive from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) policy attachments: %s", d.Id(), err)
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("role_name", d.Id())
+
+	return diags
+}
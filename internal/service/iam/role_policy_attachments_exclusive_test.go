@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRolePolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", "aws_iam_role.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test1", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBandPolicy(ctx, rName, fmt.Sprintf("arn:%s:iam::aws:policy/ReadOnlyAccess", acctest.Partition())),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentCount(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test1", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBandPolicy(ctx context.Context, roleName, policyARN string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		input := &iam.AttachRolePolicyInput{
+			PolicyArn: aws.String(policyARN),
+			RoleName:  aws.String(roleName),
+		}
+
+		_, err := conn.AttachRolePolicy(ctx, input)
+
+		return err
+	}
+}
+
+func testAccRolePolicyAttachmentsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRolePolicyAttachmentConfig_attach(rName, rName), `
+resource "aws_iam_role_policy_attachments_exclusive" "test" {
+  role_name   = aws_iam_role.test.name
+  policy_arns = [aws_iam_role_policy_attachment.test1.policy_arn]
+}
+`)
+}
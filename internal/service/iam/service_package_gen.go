@@ -190,6 +190,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Role",
 			},
 		},
+		{
+			Factory:  resourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+			Name:     "Role Policies Exclusive",
+		},
 		{
 			Factory:  resourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -200,6 +205,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
 		},
+		{
+			Factory:  resourceRolePolicyAttachmentsExclusive,
+			TypeName: "aws_iam_role_policy_attachments_exclusive",
+			Name:     "Role Policy Attachments Exclusive",
+		},
 		{
 			Factory:  resourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
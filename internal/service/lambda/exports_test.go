@@ -32,5 +32,8 @@ var (
 	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
 	LayerVersionParseResourceID                  = layerVersionParseResourceID
 	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
+	RuntimeDeprecation                           = runtimeDeprecation
 	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	SourceDirHash                                = sourceDirHash
+	ZipDirectory                                 = zipDirectory
 )
@@ -4,10 +4,16 @@
 package lambda
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -167,7 +173,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -206,7 +212,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -283,6 +289,11 @@ func resourceFunction() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"publish_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+			},
 			"qualified_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -324,7 +335,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -335,7 +346,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -378,6 +389,12 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"timeout": {
@@ -456,6 +473,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			updateSourceCodeHashForSourceDir,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -492,6 +510,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 		}
 
+		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		zipFile, err := zipDirectory(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ZIP archive from directory (%s): %s", v, err)
+		}
+
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
@@ -698,7 +727,10 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	if err := d.Set("snap_start", flattenSnapStart(function.SnapStart)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting snap_start: %s", err)
 	}
-	d.Set("source_code_hash", function.CodeSha256)
+	// The source code hash of a function packaged from source_dir is the hash of the directory's contents, not of the deployment package.
+	if _, ok := d.GetOk("source_dir"); !ok {
+		d.Set("source_code_hash", function.CodeSha256)
+	}
 	d.Set("source_code_size", function.CodeSize)
 	d.Set("timeout", function.Timeout)
 	tracingConfigMode := awstypes.TracingModePassThrough
@@ -924,7 +956,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) configuration: %s", d.Id(), err)
 		}
 
-		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), FunctionVersionLatest, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Lambda Function (%s) configuration update: %s", d.Id(), err)
 		}
 	}
@@ -957,6 +989,21 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "reading ZIP file (%s): %s", v, err)
 			}
 
+			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			zipFile, err := zipDirectory(v.(string))
+
+			if err != nil {
+				// As source_dir isn't set in resourceFunctionRead(), don't ovewrite the last known good value.
+				old, _ := d.GetChange("source_dir")
+				d.Set("source_dir", old)
+
+				return sdkdiag.AppendErrorf(diags, "creating ZIP archive from directory (%s): %s", v, err)
+			}
+
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
@@ -982,7 +1029,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
 		}
 
-		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), FunctionVersionLatest, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: waiting for completion: %s", d.Id(), err)
 		}
 	}
@@ -1013,7 +1060,10 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			FunctionName: aws.String(d.Id()),
 		}
 
-		outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ResourceConflictException](ctx, lambdaPropagationTimeout, func() (interface{}, error) {
+		timeout := functionPublishTimeout(d, d.Timeout(schema.TimeoutUpdate))
+		deadline := time.Now().Add(timeout)
+
+		outputRaw, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.ResourceConflictException](ctx, timeout, func() (interface{}, error) {
 			return conn.PublishVersion(ctx, input)
 		}, "in progress")
 
@@ -1023,12 +1073,7 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		output := outputRaw.(*lambda.PublishVersionOutput)
 
-		err = lambda.NewFunctionUpdatedWaiter(conn).Wait(ctx, &lambda.GetFunctionConfigurationInput{
-			FunctionName: output.FunctionArn,
-			Qualifier:    output.Version,
-		}, d.Timeout(schema.TimeoutUpdate))
-
-		if err != nil {
+		if _, err := waitFunctionUpdated(ctx, conn, d.Id(), aws.ToString(output.Version), time.Until(deadline)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "publishing Lambda Function (%s) version: waiting for completion: %s", d.Id(), err)
 		}
 	}
@@ -1120,9 +1165,12 @@ func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, n
 	return output, nil
 }
 
-func statusFunctionLastUpdateStatus(ctx context.Context, conn *lambda.Client, name string) retry.StateRefreshFunc {
+func statusFunctionLastUpdateStatus(ctx context.Context, conn *lambda.Client, name, qualifier string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := findFunctionByName(ctx, conn, name)
+		output, err := findFunction(ctx, conn, &lambda.GetFunctionInput{
+			FunctionName: aws.String(name),
+			Qualifier:    aws.String(qualifier),
+		})
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -1172,11 +1220,13 @@ func waitFunctionCreated(ctx context.Context, conn *lambda.Client, name string,
 	return nil, err
 }
 
-func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName string, timeout time.Duration) (*awstypes.FunctionConfiguration, error) { //nolint:unparam
+// waitFunctionUpdated waits for the last update of the specified function version to complete successfully.
+// The reason for a failed update is included in the returned error.
+func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName, qualifier string, timeout time.Duration) (*awstypes.FunctionConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.LastUpdateStatusInProgress),
 		Target:  enum.Slice(awstypes.LastUpdateStatusSuccessful),
-		Refresh: statusFunctionLastUpdateStatus(ctx, conn, functionName),
+		Refresh: statusFunctionLastUpdateStatus(ctx, conn, functionName, qualifier),
 		Timeout: timeout,
 		Delay:   5 * time.Second,
 	}
@@ -1184,7 +1234,9 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if output, ok := outputRaw.(*awstypes.FunctionConfiguration); ok {
-		tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastUpdateStatusReasonCode), aws.ToString(output.LastUpdateStatusReason)))
+		if reason := aws.ToString(output.LastUpdateStatusReason); reason != "" {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", string(output.LastUpdateStatusReasonCode), reason))
+		}
 
 		return output, err
 	}
@@ -1192,6 +1244,19 @@ func waitFunctionUpdated(ctx context.Context, conn *lambda.Client, functionName
 	return nil, err
 }
 
+// functionPublishTimeout returns the time to wait for a new version of the function to be published.
+// Publishing a version of a large or VPC-attached function may take much longer than updating its configuration,
+// so the wait can be configured separately and falls back to the operation timeout.
+func functionPublishTimeout(d *schema.ResourceData, operationTimeout time.Duration) time.Duration {
+	if v, ok := d.GetOk("publish_timeout"); ok {
+		if timeout, _ := time.ParseDuration(v.(string)); timeout > 0 {
+			return timeout
+		}
+	}
+
+	return operationTimeout
+}
+
 // retryFunctionOp retries a Lambda Function Create or Update operation.
 // It handles IAM eventual consistency and EC2 throttling.
 type functionCU interface {
@@ -1263,6 +1328,29 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+// updateSourceCodeHashForSourceDir sets source_code_hash to the hash of the contents of source_dir
+// so that changes to the directory's contents are detected at plan time.
+func updateSourceCodeHashForSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	sourceCodeHash, err := sourceDirHash(v.(string))
+
+	if err != nil {
+		return fmt.Errorf("hashing directory (%s): %w", v, err)
+	}
+
+	if d.Get("source_code_hash").(string) != sourceCodeHash {
+		if err := d.SetNew("source_code_hash", sourceCodeHash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -1283,6 +1371,7 @@ func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff
 func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 	return d.HasChange("filename") ||
 		d.HasChange("source_code_hash") ||
+		d.HasChange("source_dir") ||
 		d.HasChange(names.AttrS3Bucket) ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
@@ -1326,6 +1415,148 @@ func readFileContents(v string) ([]byte, error) {
 	return fileContent, nil
 }
 
+// zipDirectoryModified is the modification time recorded for every archive entry.
+// It is the earliest time representable in the MS-DOS date format used by ZIP archives.
+var zipDirectoryModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// zipDirectory returns a ZIP archive of the regular files under the specified directory.
+// Entries are written in lexical order with forward slash separators, a fixed modification time and fixed permissions,
+// so that the archive doesn't depend on checkout time or host platform.
+func zipDirectory(v string) ([]byte, error) {
+	files, entryNames, err := sourceDirFiles(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range entryNames {
+		header := &zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: zipDirectoryModified,
+		}
+		// Files are always marked executable so that custom runtime bootstrap files work
+		// on hosts that don't track permission bits.
+		header.SetMode(0o755)
+
+		f, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(files[name])
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := f.Write(content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sourceDirHash returns the base64-encoded SHA256 hash of the entry names and contents of the regular files under the specified directory.
+// The hash is used as the source code hash instead of the hash of the archive, as the output of compress/flate can change between Go releases.
+func sourceDirHash(v string) (string, error) {
+	files, entryNames, err := sourceDirFiles(v)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+
+	for _, name := range entryNames {
+		content, err := os.ReadFile(files[name])
+		if err != nil {
+			return "", err
+		}
+
+		// Entry names can't contain NUL characters, and each file is identified by the hash of its contents.
+		contentHash := sha256.Sum256(content)
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write(contentHash[:])
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// sourceDirFiles returns the paths of the regular files under the specified directory, keyed by archive entry name, and the sorted entry names.
+func sourceDirFiles(v string) (map[string]string, []string, error) {
+	dir, err := homedir.Expand(v)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string]string)
+	if err := zipDirectoryFiles(dir, "", make(map[string]struct{}), files); err != nil {
+		return nil, nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("directory (%s) contains no files", v)
+	}
+
+	entryNames := make([]string, 0, len(files))
+	for name := range files {
+		entryNames = append(entryNames, name)
+	}
+	slices.Sort(entryNames)
+
+	return files, entryNames, nil
+}
+
+// zipDirectoryFiles adds the paths of the regular files under the specified directory to files, keyed by archive entry name.
+// Symbolic links are followed, so a link to a directory adds the files under the linked directory.
+// ancestors holds the resolved paths of the directories being walked, to detect symbolic link loops.
+func zipDirectoryFiles(dir, prefix string, ancestors map[string]struct{}, files map[string]string) error {
+	realDir, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := ancestors[realDir]; ok {
+		return fmt.Errorf("directory (%s) is a symbolic link to one of its parent directories", dir)
+	}
+	ancestors[realDir] = struct{}{}
+	defer delete(ancestors, realDir)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		name := prefix + entry.Name()
+
+		// os.Stat follows symbolic links.
+		fi, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case fi.IsDir():
+			if err := zipDirectoryFiles(path, name+"/", ancestors, files); err != nil {
+				return err
+			}
+		case fi.Mode().IsRegular():
+			files[name] = path
+		}
+	}
+
+	return nil
+}
+
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/set-up-lambda-custom-integrations.html.
 func invokeARN(c *conns.AWSClient, functionOrAliasARN string) string {
 	return arn.ARN{
@@ -5,10 +5,12 @@ package lambda_test
 
 import (
 	"archive/zip"
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -625,6 +627,44 @@ func TestAccLambdaFunction_versioned(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_publishTimeout(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_publishTimeout(rName, "Initial", "20m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "publish_timeout", "20m"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_publishTimeout(rName, "Updated", "30m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "publish_timeout", "30m"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"filename", "publish", "publish_timeout"},
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_versionedUpdate(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -1920,6 +1960,206 @@ func TestAccLambdaFunction_localUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	dir := t.TempDir()
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	var timeBeforeUpdate time.Time
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := testAccCopyFile("test-fixtures/lambda_func.js", filepath.Join(dir, "lambda.js")); err != nil {
+						t.Fatalf("error copying file: %s", err)
+					}
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceDirHashAttr(dir, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", dir),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish", "source_code_hash", "source_dir"},
+			},
+			{
+				PreConfig: func() {
+					if err := testAccCopyFile("test-fixtures/lambda_func_modified.js", filepath.Join(dir, "lambda.js")); err != nil {
+						t.Fatalf("error copying file: %s", err)
+					}
+					timeBeforeUpdate = time.Now()
+				},
+				Config: testAccFunctionConfig_sourceDir(dir, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckSourceDirHashAttr(dir, resourceName),
+					func(s *terraform.State) error {
+						return testAccCheckAttributeIsDateAfter(s, resourceName, "last_modified", timeBeforeUpdate)
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					// Touching the file without changing its content must not produce a diff.
+					now := time.Now()
+					if err := os.Chtimes(filepath.Join(dir, "lambda.js"), now, now); err != nil {
+						t.Fatalf("error updating file times: %s", err)
+					}
+				},
+				Config:   testAccFunctionConfig_sourceDir(dir, rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestZipDirectory(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":         "exports.handler = async () => 'hello';",
+		"lib/helper.js":    "module.exports = {};",
+		"lib/nested/a.txt": "a",
+	}
+
+	writeFiles := func(t *testing.T, files map[string]string, modified time.Time) string {
+		t.Helper()
+
+		dir := t.TempDir()
+		for name, content := range files {
+			path := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chtimes(path, modified, modified); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return dir
+	}
+
+	dir1 := writeFiles(t, files, time.Date(2020, time.March, 1, 12, 0, 0, 0, time.UTC))
+	got1, err := tflambda.ZipDirectory(dir1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir2 := writeFiles(t, files, time.Now())
+	got2, err := tflambda.ZipDirectory(dir2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got1, got2) {
+		t.Errorf("archives of identical directory contents differ")
+	}
+
+	hash1, err := tflambda.SourceDirHash(dir1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash2, err := tflambda.SourceDirHash(dir2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("hashes of identical directory contents differ")
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(got1), int64(len(got1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotNames []string
+	for _, f := range r.File {
+		gotNames = append(gotNames, f.Name)
+	}
+	if wantNames := []string{"index.js", "lib/helper.js", "lib/nested/a.txt"}; !slices.Equal(gotNames, wantNames) {
+		t.Errorf("archive entries = %v, want %v", gotNames, wantNames)
+	}
+
+	files["lib/nested/a.txt"] = "b"
+	dir3 := writeFiles(t, files, time.Now())
+	got3, err := tflambda.ZipDirectory(dir3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Equal(got1, got3) {
+		t.Errorf("archives of different directory contents are identical")
+	}
+
+	hash3, err := tflambda.SourceDirHash(dir3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 == hash3 {
+		t.Errorf("hashes of different directory contents are identical")
+	}
+
+	if _, err := tflambda.ZipDirectory(t.TempDir()); err == nil {
+		t.Errorf("expected error for empty directory")
+	}
+
+	// Symbolic links to directories are followed.
+	dir := writeFiles(t, map[string]string{"index.js": "exports.handler = async () => 'hello';"}, time.Now())
+	shared := writeFiles(t, map[string]string{"helper.js": "module.exports = {};"}, time.Now())
+	if err := os.Symlink(shared, filepath.Join(dir, "shared")); err != nil {
+		t.Fatal(err)
+	}
+
+	got4, err := tflambda.ZipDirectory(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err = zip.NewReader(bytes.NewReader(got4), int64(len(got4)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotNames = nil
+	for _, f := range r.File {
+		gotNames = append(gotNames, f.Name)
+	}
+	if wantNames := []string{"index.js", "shared/helper.js"}; !slices.Equal(gotNames, wantNames) {
+		t.Errorf("archive entries = %v, want %v", gotNames, wantNames)
+	}
+
+	// Symbolic link loops are an error.
+	if err := os.Symlink(dir, filepath.Join(shared, "loop")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tflambda.ZipDirectory(dir); err == nil {
+		t.Errorf("expected error for symbolic link loop")
+	}
+}
+
 func TestAccLambdaFunction_LocalUpdate_nameOnly(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2453,6 +2693,17 @@ func testAccCheckSourceCodeHash(function *lambda.GetFunctionOutput, expectedHash
 	}
 }
 
+func testAccCheckSourceDirHashAttr(dir, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		hash, err := tflambda.SourceDirHash(dir)
+		if err != nil {
+			return err
+		}
+
+		return resource.TestCheckResourceAttr(n, "source_code_hash", hash)(s)
+	}
+}
+
 func testAccCheckAttributeIsDateAfter(s *terraform.State, name string, key string, before time.Time) error {
 	rs, ok := s.RootModule().Resources[name]
 	if !ok {
@@ -2512,6 +2763,15 @@ func testAccCreateZipFromFiles(files map[string]string, zipFile *os.File) error
 	return w.Flush()
 }
 
+func testAccCopyFile(source, destination string) error {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(destination, content, 0o644)
+}
+
 func createTempFile(prefix string) (string, *os.File, error) {
 	f, err := os.CreateTemp(os.TempDir(), prefix)
 	if err != nil {
@@ -3061,6 +3321,23 @@ resource "aws_lambda_function" "test" {
 `, fileName, rName, publish))
 }
 
+func testAccFunctionConfig_publishTimeout(rName, description, publishTimeout string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename        = "test-fixtures/lambdatest.zip"
+  function_name   = %[1]q
+  description     = %[2]q
+  publish         = true
+  publish_timeout = %[3]q
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "exports.example"
+  runtime         = "nodejs16.x"
+}
+`, rName, description, publishTimeout))
+}
+
 func testAccFunctionConfig_versionedNodeJs20xRuntime(fileName, rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -3857,6 +4134,38 @@ resource "aws_lambda_function" "test" {
 `, filePath, rName)
 }
 
+func testAccFunctionConfig_sourceDir(sourceDir, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "iam_for_lambda" {
+  name = %[2]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow",
+      "Sid": ""
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  source_dir    = %[1]q
+  function_name = %[2]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs16.x"
+}
+`, sourceDir, rName)
+}
+
 func testAccFunctionConfig_localNameOnly(filePath, rName string) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "iam_for_lambda" {
@@ -5,15 +5,55 @@ package lambda
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// runtimeDeprecationDates maps Lambda runtimes to the date from which AWS no longer applies
+// security patches or other updates to them.
+// The table is maintained by hand and must be updated when AWS announces a runtime deprecation.
+// See https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html#runtimes-deprecated.
+var runtimeDeprecationDates = map[string]string{
+	"dotnet6":        "2024-12-20",
+	"dotnetcore1.0":  "2019-07-30",
+	"dotnetcore2.0":  "2019-05-30",
+	"dotnetcore2.1":  "2022-01-05",
+	"dotnetcore3.1":  "2023-04-03",
+	"go1.x":          "2024-01-08",
+	"java8":          "2024-01-08",
+	"nodejs":         "2016-10-31",
+	"nodejs10.x":     "2021-07-30",
+	"nodejs12.x":     "2023-03-31",
+	"nodejs14.x":     "2023-12-04",
+	"nodejs16.x":     "2024-06-12",
+	"nodejs18.x":     "2025-09-01",
+	"nodejs4.3":      "2020-03-05",
+	"nodejs4.3-edge": "2019-04-30",
+	"nodejs6.10":     "2019-08-12",
+	"nodejs8.10":     "2020-03-06",
+	"provided":       "2024-01-08",
+	"python2.7":      "2021-07-15",
+	"python3.6":      "2022-07-18",
+	"python3.7":      "2023-12-04",
+	"python3.8":      "2024-10-14",
+	"python3.9":      "2025-12-15",
+	"ruby2.5":        "2021-07-30",
+	"ruby2.7":        "2023-12-07",
+	"ruby3.2":        "2026-03-31",
+}
+
 // @SDKDataSource("aws_lambda_functions", name="Functions")
 func dataSourceFunctions() *schema.Resource {
 	return &schema.Resource{
@@ -30,6 +70,58 @@ func dataSourceFunctions() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"functions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"function_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runtime": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runtime_deprecated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"runtime_deprecation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"runtime_deprecation_upcoming": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"deprecated_runtimes_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"deprecation_window_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      180,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtimes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchema(),
 		},
 	}
 }
@@ -38,8 +130,16 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
+	deprecatedRuntimesOnly := d.Get("deprecated_runtimes_only").(bool)
+	deprecationWindow := time.Duration(d.Get("deprecation_window_days").(int)) * 24 * time.Hour
+	namePrefix := d.Get(names.AttrNamePrefix).(string)
+	runtimes := flex.ExpandStringValueSet(d.Get("runtimes").(*schema.Set))
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	now := time.Now()
+
 	var functionARNs []string
 	var functionNames []string
+	var functions []interface{}
 
 	input := &lambda.ListFunctionsInput{}
 	pages := lambda.NewListFunctionsPaginator(conn, input)
@@ -51,14 +151,75 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		}
 
 		for _, v := range page.Functions {
-			functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
-			functionNames = append(functionNames, aws.ToString(v.FunctionName))
+			arn, name, runtime := aws.ToString(v.FunctionArn), aws.ToString(v.FunctionName), string(v.Runtime)
+
+			if !strings.HasPrefix(name, namePrefix) {
+				continue
+			}
+
+			if len(runtimes) > 0 && !slices.Contains(runtimes, runtime) {
+				continue
+			}
+
+			deprecationDate, deprecated, deprecationUpcoming := runtimeDeprecation(runtime, now, deprecationWindow)
+
+			if deprecatedRuntimesOnly && !deprecated {
+				continue
+			}
+
+			if len(tags) > 0 {
+				functionTags, err := listTags(ctx, conn, arn)
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "listing tags for Lambda Function (%s): %s", arn, err)
+				}
+
+				if !functionTags.ContainsAll(tags) {
+					continue
+				}
+			}
+
+			functionARNs = append(functionARNs, arn)
+			functionNames = append(functionNames, name)
+			functions = append(functions, map[string]interface{}{
+				names.AttrARN:                  arn,
+				"function_name":                name,
+				"runtime":                      runtime,
+				"runtime_deprecated":           deprecated,
+				"runtime_deprecation_date":     deprecationDate,
+				"runtime_deprecation_upcoming": deprecationUpcoming,
+			})
 		}
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set("function_arns", functionARNs)
 	d.Set("function_names", functionNames)
+	if err := d.Set("functions", functions); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting functions: %s", err)
+	}
 
 	return diags
 }
+
+// runtimeDeprecation returns the deprecation date of the specified Lambda runtime, if known,
+// whether the runtime is deprecated at the specified time and whether it will be deprecated within the specified window.
+// Container image functions have no runtime and are never deprecated.
+// Runtimes that aren't in runtimeDeprecationDates have an empty date.
+func runtimeDeprecation(runtime string, now time.Time, window time.Duration) (string, bool, bool) {
+	v, ok := runtimeDeprecationDates[runtime]
+	if !ok {
+		return "", false, false
+	}
+
+	date, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		return v, false, false
+	}
+
+	if !now.Before(date) {
+		return v, true, false
+	}
+
+	return v, false, !now.Add(window).Before(date)
+}
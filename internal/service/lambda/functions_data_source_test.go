@@ -4,14 +4,79 @@
 package lambda_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestRuntimeDeprecation(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)
+	window := 180 * 24 * time.Hour
+
+	testCases := map[string]struct {
+		runtime        string
+		window         time.Duration
+		wantDate       string
+		wantDeprecated bool
+		wantUpcoming   bool
+	}{
+		"deprecated": {
+			runtime:        "nodejs16.x",
+			window:         window,
+			wantDate:       "2024-06-12",
+			wantDeprecated: true,
+		},
+		"deprecation upcoming": {
+			runtime:      "python3.8",
+			window:       window,
+			wantDate:     "2024-10-14",
+			wantUpcoming: true,
+		},
+		"deprecation outside window": {
+			runtime:  "python3.8",
+			window:   30 * 24 * time.Hour,
+			wantDate: "2024-10-14",
+		},
+		"unknown": {
+			runtime: "nodejs20.x",
+			window:  window,
+		},
+		"container image": {
+			window: window,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotDate, gotDeprecated, gotUpcoming := tflambda.RuntimeDeprecation(testCase.runtime, now, testCase.window)
+
+			if gotDate != testCase.wantDate {
+				t.Errorf("deprecation date = %q, want %q", gotDate, testCase.wantDate)
+			}
+
+			if gotDeprecated != testCase.wantDeprecated {
+				t.Errorf("deprecated = %t, want %t", gotDeprecated, testCase.wantDeprecated)
+			}
+
+			if gotUpcoming != testCase.wantUpcoming {
+				t.Errorf("deprecation upcoming = %t, want %t", gotUpcoming, testCase.wantUpcoming)
+			}
+		})
+	}
+}
+
 func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -40,3 +105,69 @@ data "aws_lambda_functions" "test" {
 }
 `)
 }
+
+func TestAccLambdaFunctionsDataSource_filters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_filters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_names.0", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "functions.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "functions.0.function_name", resourceName, "function_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.runtime", "nodejs16.x"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.runtime_deprecated", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.runtime_deprecation_date", "2024-06-12"),
+					resource.TestCheckResourceAttr(dataSourceName, "functions.0.runtime_deprecation_upcoming", "false"),
+					resource.TestCheckResourceAttr(dataSourceName+"_other_runtime", "functions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName+"_other_tag", "functions.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFunctionsDataSourceConfig_filters(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_tags1(rName, "key1", "value1"), fmt.Sprintf(`
+data "aws_lambda_functions" "test" {
+  name_prefix              = %[1]q
+  runtimes                 = ["nodejs16.x"]
+  deprecated_runtimes_only = true
+
+  tags = {
+    key1 = "value1"
+  }
+
+  depends_on = [aws_lambda_function.test]
+}
+
+data "aws_lambda_functions" "test_other_runtime" {
+  name_prefix = %[1]q
+  runtimes    = ["python3.12"]
+
+  depends_on = [aws_lambda_function.test]
+}
+
+data "aws_lambda_functions" "test_other_tag" {
+  name_prefix = %[1]q
+
+  tags = {
+    key1 = "value2"
+  }
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName))
+}
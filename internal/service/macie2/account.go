@@ -34,6 +34,22 @@ func ResourceAccount() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"automated_discovery_statistics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classification_error": sensitivityAggregationsSchema(),
+						"last_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"not_classified": sensitivityAggregationsSchema(),
+						"not_sensitive":  sensitivityAggregationsSchema(),
+						"sensitive":      sensitivityAggregationsSchema(),
+					},
+				},
+			},
 			"finding_publishing_frequency": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -67,6 +83,10 @@ func resourceAccountCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
 
+	if err := ensureServiceLinkedRole(ctx, meta.(*conns.AWSClient).IAMClient(ctx)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "enabling Macie Account: %s", err)
+	}
+
 	input := &macie2.EnableMacieInput{
 		ClientToken: aws.String(id.UniqueId()),
 	}
@@ -130,6 +150,16 @@ func resourceAccountRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set(names.AttrCreatedAt, aws.TimeValue(resp.CreatedAt).Format(time.RFC3339))
 	d.Set("updated_at", aws.TimeValue(resp.UpdatedAt).Format(time.RFC3339))
 
+	// The statistics are informational, so failing to read them (e.g. missing macie2:GetBucketStatistics permission) doesn't fail the read.
+	statistics, err := conn.GetBucketStatisticsWithContext(ctx, &macie2.GetBucketStatisticsInput{})
+
+	if err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading Macie Account (%s) bucket statistics: %s", d.Id(), err)
+		d.Set("automated_discovery_statistics", nil)
+	} else if err := d.Set("automated_discovery_statistics", flattenAutomatedDiscoveryStatistics(statistics)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting automated_discovery_statistics: %s", err)
+	}
+
 	return diags
 }
 
@@ -195,3 +225,66 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 	return diags
 }
+
+func sensitivityAggregationsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"classifiable_size_in_bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"publicly_accessible_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"total_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"total_size_in_bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenAutomatedDiscoveryStatistics(output *macie2.GetBucketStatisticsOutput) []map[string]interface{} {
+	if output == nil {
+		return nil
+	}
+
+	statistics := map[string]interface{}{
+		"last_updated": "",
+	}
+
+	if output.LastUpdated != nil {
+		statistics["last_updated"] = aws.TimeValue(output.LastUpdated).Format(time.RFC3339)
+	}
+
+	if v := output.BucketStatisticsBySensitivity; v != nil {
+		statistics["classification_error"] = flattenSensitivityAggregations(v.ClassificationError)
+		statistics["not_classified"] = flattenSensitivityAggregations(v.NotClassified)
+		statistics["not_sensitive"] = flattenSensitivityAggregations(v.NotSensitive)
+		statistics["sensitive"] = flattenSensitivityAggregations(v.Sensitive)
+	}
+
+	return []map[string]interface{}{statistics}
+}
+
+func flattenSensitivityAggregations(aggregations *macie2.SensitivityAggregations) []map[string]interface{} {
+	if aggregations == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"classifiable_size_in_bytes": aws.Int64Value(aggregations.ClassifiableSizeInBytes),
+		"publicly_accessible_count":  aws.Int64Value(aggregations.PubliclyAccessibleCount),
+		"total_count":                aws.Int64Value(aggregations.TotalCount),
+		"total_size_in_bytes":        aws.Int64Value(aggregations.TotalSizeInBytes),
+	}}
+}
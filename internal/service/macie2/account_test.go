@@ -38,6 +38,8 @@ func testAccAccount_basic(t *testing.T) {
 					acctest.CheckResourceAttrGlobalARN(resourceName, "service_role", "iam", "role/aws-service-role/macie.amazonaws.com/AWSServiceRoleForAmazonMacie"),
 					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedAt),
 					acctest.CheckResourceAttrRFC3339(resourceName, "updated_at"),
+					// Statistics are only available once automated sensitive data discovery has run.
+					resource.TestCheckResourceAttrSet(resourceName, "automated_discovery_statistics.#"),
 				),
 			},
 			{
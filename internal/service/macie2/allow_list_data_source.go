@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_macie2_allow_list")
+func DataSourceAllowList() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAllowListRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"criteria": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"regex": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"s3_words_list": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucketName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"object_key": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrStatus: allowListStatusSchema(),
+			names.AttrTags:   tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func allowListStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrDescription: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAllowListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get(names.AttrName).(string)
+	output, err := findAllowListByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Allow List (%s): %s", name, tfresource.SingularDataSourceFindError("Macie Allow List", err))
+	}
+
+	d.SetId(aws.StringValue(output.Id))
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrCreatedAt, aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	if err := d.Set("criteria", flattenAllowListCriteria(output.Criteria)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting criteria: %s", err)
+	}
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+	if err := d.Set(names.AttrStatus, flattenAllowListStatus(output.Status)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting status: %s", err)
+	}
+	if err := d.Set(names.AttrTags, KeyValueTags(ctx, output.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+	d.Set("updated_at", aws.TimeValue(output.UpdatedAt).Format(time.RFC3339))
+
+	return diags
+}
+
+func flattenAllowListCriteria(apiObject *macie2.AllowListCriteria) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"regex": aws.StringValue(apiObject.Regex),
+	}
+
+	if v := apiObject.S3WordsList; v != nil {
+		tfMap["s3_words_list"] = []interface{}{map[string]interface{}{
+			names.AttrBucketName: aws.StringValue(v.BucketName),
+			"object_key":         aws.StringValue(v.ObjectKey),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAllowListStatus(apiObject *macie2.AllowListStatus) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"code":                aws.StringValue(apiObject.Code),
+		names.AttrDescription: aws.StringValue(apiObject.Description),
+	}
+
+	return []interface{}{tfMap}
+}
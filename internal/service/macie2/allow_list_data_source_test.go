@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAllowListDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_macie2_allow_list.test"
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_basic(),
+			},
+			{
+				PreConfig: func() {
+					id = testAccCreateAllowList(ctx, t, rName, "^ABC-[0-9]{4}$")
+				},
+				Config: testAccAllowListDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, names.AttrARN, "macie2", regexache.MustCompile(`allow-list/.+`)),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(dataSourceName, "criteria.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "criteria.0.regex", "^ABC-[0-9]{4}$"),
+					resource.TestCheckResourceAttr(dataSourceName, "criteria.0.s3_words_list.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(dataSourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.code", macie2.AllowListStatusCodeOk),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttrSet(dataSourceName, "updated_at"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDeleteAllowList(ctx, t, id)
+				},
+				Config: testAccAccountConfig_basic(),
+			},
+		},
+	})
+}
+
+// testAccCreateAllowList creates an allow list outside of Terraform and returns its ID.
+func testAccCreateAllowList(ctx context.Context, t *testing.T, name, regex string) string {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := conn.CreateAllowListWithContext(ctx, &macie2.CreateAllowListInput{
+		ClientToken: aws.String(sdkacctest.RandString(32)),
+		Criteria: &macie2.AllowListCriteria{
+			Regex: aws.String(regex),
+		},
+		Description: aws.String("test"),
+		Name:        aws.String(name),
+		Tags:        aws.StringMap(map[string]string{"Name": name}),
+	})
+
+	if err != nil {
+		t.Fatalf("creating Macie Allow List (%s): %s", name, err)
+	}
+
+	return aws.StringValue(output.Id)
+}
+
+func testAccDeleteAllowList(ctx context.Context, t *testing.T, id string) {
+	t.Helper()
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+	_, err := conn.DeleteAllowListWithContext(ctx, &macie2.DeleteAllowListInput{
+		Id: aws.String(id),
+	})
+
+	if err != nil {
+		t.Fatalf("deleting Macie Allow List (%s): %s", id, err)
+	}
+}
+
+func testAccAllowListDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccountConfig_basic(), fmt.Sprintf(`
+data "aws_macie2_allow_list" "test" {
+  name = %[1]q
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName))
+}
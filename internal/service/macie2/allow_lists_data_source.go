@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_macie2_allow_lists")
+func DataSourceAllowLists() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAllowListsRead,
+
+		Schema: map[string]*schema.Schema{
+			"allow_lists": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: allowListStatusSchema(),
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAllowListsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	allowLists, err := findAllowLists(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Allow Lists: %s", err)
+	}
+
+	var arns, ids, resourceNames []string
+	var tfList []interface{}
+
+	for _, v := range allowLists {
+		id := aws.StringValue(v.Id)
+
+		// The status of an allow list is only returned when it's described individually.
+		output, err := findAllowListByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Allow List (%s): %s", id, err)
+		}
+
+		arns = append(arns, aws.StringValue(output.Arn))
+		ids = append(ids, id)
+		resourceNames = append(resourceNames, aws.StringValue(output.Name))
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:         aws.StringValue(output.Arn),
+			names.AttrCreatedAt:   aws.TimeValue(output.CreatedAt).Format(time.RFC3339),
+			names.AttrDescription: aws.StringValue(output.Description),
+			names.AttrID:          id,
+			names.AttrName:        aws.StringValue(output.Name),
+			names.AttrStatus:      flattenAllowListStatus(output.Status),
+			"updated_at":          aws.TimeValue(output.UpdatedAt).Format(time.RFC3339),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("allow_lists", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting allow_lists: %s", err)
+	}
+	d.Set(names.AttrARNs, arns)
+	d.Set("ids", ids)
+	d.Set("names", resourceNames)
+
+	return diags
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAllowListsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_macie2_allow_lists.test"
+	var id1, id2 string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountConfig_basic(),
+			},
+			{
+				PreConfig: func() {
+					id1 = testAccCreateAllowList(ctx, t, rName1, "^ABC-[0-9]{4}$")
+					id2 = testAccCreateAllowList(ctx, t, rName2, "^DEF-[0-9]{4}$")
+				},
+				Config: testAccAllowListsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "allow_lists.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", rName1),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "names.*", rName2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "allow_lists.*", map[string]string{
+						names.AttrName:  rName1,
+						"status.0.code": "OK",
+					}),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDeleteAllowList(ctx, t, id1)
+					testAccDeleteAllowList(ctx, t, id2)
+				},
+				Config: testAccAccountConfig_basic(),
+			},
+		},
+	})
+}
+
+func testAccAllowListsDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccAccountConfig_basic(), `
+data "aws_macie2_allow_lists" "test" {
+  depends_on = [aws_macie2_account.test]
+}
+`)
+}
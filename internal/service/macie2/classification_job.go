@@ -55,21 +55,21 @@ func ResourceClassificationJob() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"daily_schedule": {
-							Type:          schema.TypeBool,
-							Optional:      true,
-							ConflictsWith: []string{"schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+							Type:         schema.TypeBool,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
 						},
 						"weekly_schedule": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ConflictsWith: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.monthly_schedule"},
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+							ValidateFunc: validation.StringInSlice(macie2.DayOfWeek_Values(), false),
 						},
 						"monthly_schedule": {
-							Type:          schema.TypeInt,
-							Optional:      true,
-							Computed:      true,
-							ConflictsWith: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule"},
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ExactlyOneOf: []string{"schedule_frequency.0.daily_schedule", "schedule_frequency.0.weekly_schedule", "schedule_frequency.0.monthly_schedule"},
+							ValidateFunc: validation.IntBetween(1, 31),
 						},
 					},
 				},
@@ -1058,15 +1058,17 @@ func flattenScheduleFrequency(schedule *macie2.JobScheduleFrequency) []map[strin
 	}
 
 	var schedulesList []map[string]interface{}
-	schedMap := map[string]interface{}{}
-	if schedule.DailySchedule != nil {
-		schedMap["daily_schedule"] = true
+	// Always set every schedule type so that changes made outside Terraform, e.g. from weekly to monthly, are detected.
+	schedMap := map[string]interface{}{
+		"daily_schedule":   schedule.DailySchedule != nil,
+		"weekly_schedule":  "",
+		"monthly_schedule": 0,
 	}
-	if schedule.WeeklySchedule != nil && schedule.WeeklySchedule.DayOfWeek != nil {
-		schedMap["weekly_schedule"] = schedule.WeeklySchedule.DayOfWeek
+	if schedule.WeeklySchedule != nil {
+		schedMap["weekly_schedule"] = aws.StringValue(schedule.WeeklySchedule.DayOfWeek)
 	}
-	if schedule.MonthlySchedule != nil && schedule.MonthlySchedule.DayOfMonth != nil {
-		schedMap["monthly_schedule"] = schedule.MonthlySchedule.DayOfMonth
+	if schedule.MonthlySchedule != nil {
+		schedMap["monthly_schedule"] = int(aws.Int64Value(schedule.MonthlySchedule.DayOfMonth))
 	}
 	schedulesList = append(schedulesList, schedMap)
 
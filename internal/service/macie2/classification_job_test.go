@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -391,6 +392,55 @@ func testAccClassificationJob_BucketCriteria(t *testing.T) {
 	})
 }
 
+func testAccClassificationJob_ScheduleFrequency(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.DescribeClassificationJobOutput
+	resourceName := "aws_macie2_classification_job.test"
+	bucketName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClassificationJobDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClassificationJobConfig_scheduleFrequency(bucketName, `weekly_schedule = "MONDAY"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.daily_schedule", "false"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", "MONDAY"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.monthly_schedule", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClassificationJobConfig_scheduleFrequency(bucketName, `monthly_schedule = 15`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClassificationJobExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.daily_schedule", "false"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.weekly_schedule", ""),
+					resource.TestCheckResourceAttr(resourceName, "schedule_frequency.0.monthly_schedule", "15"),
+				),
+			},
+			{
+				Config:      testAccClassificationJobConfig_scheduleFrequency(bucketName, `monthly_schedule = 32`),
+				ExpectError: regexache.MustCompile(`expected schedule_frequency.0.monthly_schedule to be in the range \(1 - 31\)`),
+			},
+			{
+				Config:      testAccClassificationJobConfig_scheduleFrequency(bucketName, `weekly_schedule = "MON"`),
+				ExpectError: regexache.MustCompile(`expected schedule_frequency.0.weekly_schedule to be one of`),
+			},
+		},
+	})
+}
+
 func testAccCheckClassificationJobExists(ctx context.Context, resourceName string, macie2Session *macie2.DescribeClassificationJobOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -765,3 +815,31 @@ resource "aws_macie2_classification_job" "test" {
 }
 `, jobStatus, description)
 }
+
+func testAccClassificationJobConfig_scheduleFrequency(nameBucket, schedule string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_classification_job" "test" {
+  job_type = "SCHEDULED"
+  s3_job_definition {
+    bucket_definitions {
+      account_id = data.aws_caller_identity.current.account_id
+      buckets    = [aws_s3_bucket.test.bucket]
+    }
+  }
+  schedule_frequency {
+    %[2]s
+  }
+  sampling_percentage = 100
+
+  depends_on = [aws_macie2_account.test]
+}
+`, nameBucket, schedule)
+}
@@ -7,6 +7,11 @@ const (
 	tagScopeTermKeyTag = "TAG"
 )
 
+const (
+	// The only sensitivity score override supported by the Macie API.
+	maximumSensitivityScore = 100
+)
+
 func tagScopeTermKey_Values() []string {
 	return []string{
 		tagScopeTermKeyTag,
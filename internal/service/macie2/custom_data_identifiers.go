@@ -0,0 +1,348 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"maps"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_custom_data_identifiers", name="Custom Data Identifiers")
+func ResourceCustomDataIdentifiers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomDataIdentifiersCreate,
+		ReadWithoutTimeout:   resourceCustomDataIdentifiersRead,
+		UpdateWithoutTimeout: resourceCustomDataIdentifiersUpdate,
+		DeleteWithoutTimeout: resourceCustomDataIdentifiersDelete,
+
+		CustomizeDiff: customizeDiffCustomDataIdentifierNames,
+
+		Schema: map[string]*schema.Schema{
+			"custom_data_identifier": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 512),
+						},
+						"ignore_words": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(4, 90),
+							},
+						},
+						"keywords": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 50,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(3, 90),
+							},
+						},
+						"maximum_match_distance": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      50,
+							ValidateFunc: validation.IntBetween(1, 300),
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+						},
+						"regex": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 512),
+						},
+						"severity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      macie2.DataIdentifierSeverityMedium,
+							ValidateFunc: validation.StringInSlice(macie2.DataIdentifierSeverity_Values(), false),
+						},
+					},
+				},
+			},
+			"ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCustomDataIdentifiersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	ids := make(map[string]string)
+	createDiags := createCustomDataIdentifiers(ctx, conn, d.Get("custom_data_identifier").(*schema.Set).List(), ids)
+
+	if len(ids) == 0 {
+		return append(diags, createDiags...)
+	}
+
+	d.SetId(id.UniqueId())
+	d.Set("ids", ids)
+
+	// An error would taint the resource and have the entries that were created replaced by the next apply.
+	// Instead, entries that could not be created are reported as warnings and are absent from state, so that
+	// the next apply creates them.
+	for _, v := range createDiags {
+		v.Severity = diag.Warning
+		diags = append(diags, v)
+	}
+
+	return append(diags, resourceCustomDataIdentifiersRead(ctx, d, meta)...)
+}
+
+func resourceCustomDataIdentifiersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	ids := make(map[string]string)
+	var tfList []interface{}
+
+	for name, v := range flex.ExpandStringValueMap(d.Get("ids").(map[string]interface{})) {
+		output, err := findCustomDataIdentifierByID(ctx, conn, v)
+
+		if tfresource.NotFound(err) {
+			log.Printf("[WARN] Macie Custom Data Identifier (%s) %s not found, removing from state", name, v)
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Custom Data Identifier (%s) %s: %s", name, v, err)
+		}
+
+		ids[aws.StringValue(output.Name)] = v
+		tfList = append(tfList, flattenCustomDataIdentifier(output))
+	}
+
+	if !d.IsNewResource() && len(ids) == 0 {
+		log.Printf("[WARN] Macie Custom Data Identifiers (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err := d.Set("custom_data_identifier", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting custom_data_identifier: %s", err)
+	}
+	d.Set("ids", ids)
+
+	return diags
+}
+
+func resourceCustomDataIdentifiersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	ids := flex.ExpandStringValueMap(d.Get("ids").(map[string]interface{}))
+
+	if d.HasChange("custom_data_identifier") {
+		o, n := d.GetChange("custom_data_identifier")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		// Custom data identifiers can't be modified. Changed entries are replaced: the new custom data identifier
+		// is created before the old one is deleted, so that a failed creation leaves the old one in place.
+		created := make(map[string]string)
+		diags = append(diags, createCustomDataIdentifiers(ctx, conn, ns.Difference(os).List(), created)...)
+
+		configured := make(map[string]struct{})
+		for _, tfMapRaw := range ns.List() {
+			configured[tfMapRaw.(map[string]interface{})[names.AttrName].(string)] = struct{}{}
+		}
+
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			name := tfMapRaw.(map[string]interface{})[names.AttrName].(string)
+			v, ok := ids[name]
+
+			if !ok {
+				continue
+			}
+
+			if _, ok := configured[name]; ok {
+				if _, ok := created[name]; !ok {
+					continue
+				}
+			}
+
+			if err := deleteCustomDataIdentifier(ctx, conn, v); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "deleting Macie Custom Data Identifier (%s) %s: %s", name, v, err)
+
+				if _, ok := created[name]; !ok {
+					continue
+				}
+			}
+
+			delete(ids, name)
+		}
+
+		maps.Copy(ids, created)
+
+		d.Set("ids", ids)
+	}
+
+	return append(diags, resourceCustomDataIdentifiersRead(ctx, d, meta)...)
+}
+
+// customizeDiffCustomDataIdentifierNames checks that entry names are unique, as entries are tracked by name.
+func customizeDiffCustomDataIdentifierNames(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	seen := make(map[string]struct{})
+
+	for _, tfMapRaw := range d.Get("custom_data_identifier").(*schema.Set).List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Unknown names are checked once they are known.
+		name, _ := tfMap[names.AttrName].(string)
+		if name == "" {
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("custom_data_identifier name (%s) is not unique", name)
+		}
+		seen[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func resourceCustomDataIdentifiersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	for name, v := range flex.ExpandStringValueMap(d.Get("ids").(map[string]interface{})) {
+		log.Printf("[DEBUG] Deleting Macie Custom Data Identifier (%s): %s", name, v)
+		if err := deleteCustomDataIdentifier(ctx, conn, v); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting Macie Custom Data Identifier (%s) %s: %s", name, v, err)
+		}
+	}
+
+	return diags
+}
+
+// createCustomDataIdentifiers creates a custom data identifier for each entry, recording the ID of each
+// one created in ids. A failure to create one entry doesn't prevent the creation of the others.
+func createCustomDataIdentifiers(ctx context.Context, conn *macie2.Macie2, tfList []interface{}, ids map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		input := expandCreateCustomDataIdentifierInput(tfMap)
+		name := aws.StringValue(input.Name)
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 4*time.Minute, func() (interface{}, error) {
+			return conn.CreateCustomDataIdentifierWithContext(ctx, input)
+		}, macie2.ErrorCodeClientError)
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "creating Macie Custom Data Identifier (%s): %s", name, err)
+			continue
+		}
+
+		ids[name] = aws.StringValue(outputRaw.(*macie2.CreateCustomDataIdentifierOutput).CustomDataIdentifierId)
+	}
+
+	return diags
+}
+
+func deleteCustomDataIdentifier(ctx context.Context, conn *macie2.Macie2, id string) error {
+	_, err := conn.DeleteCustomDataIdentifierWithContext(ctx, &macie2.DeleteCustomDataIdentifierInput{
+		Id: aws.String(id),
+	})
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
+	}
+
+	return err
+}
+
+func expandCreateCustomDataIdentifierInput(tfMap map[string]interface{}) *macie2.CreateCustomDataIdentifierInput {
+	apiObject := &macie2.CreateCustomDataIdentifierInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Name:        aws.String(tfMap[names.AttrName].(string)),
+		Regex:       aws.String(tfMap["regex"].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["ignore_words"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.IgnoreWords = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["keywords"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Keywords = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["maximum_match_distance"].(int); ok && v != 0 {
+		apiObject.MaximumMatchDistance = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["severity"].(string); ok && v != "" {
+		apiObject.SeverityLevels = []*macie2.SeverityLevel{{
+			OccurrencesThreshold: aws.Int64(1),
+			Severity:             aws.String(v),
+		}}
+	}
+
+	return apiObject
+}
+
+func flattenCustomDataIdentifier(apiObject *macie2.GetCustomDataIdentifierOutput) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrDescription:    aws.StringValue(apiObject.Description),
+		"ignore_words":           aws.StringValueSlice(apiObject.IgnoreWords),
+		"keywords":               aws.StringValueSlice(apiObject.Keywords),
+		"maximum_match_distance": int(aws.Int64Value(apiObject.MaximumMatchDistance)),
+		names.AttrName:           aws.StringValue(apiObject.Name),
+		"regex":                  aws.StringValue(apiObject.Regex),
+		"severity":               macie2.DataIdentifierSeverityMedium,
+	}
+
+	if v := apiObject.SeverityLevels; len(v) > 0 && v[0] != nil {
+		tfMap["severity"] = aws.StringValue(v[0].Severity)
+	}
+
+	return tfMap
+}
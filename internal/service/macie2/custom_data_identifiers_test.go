@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccCustomDataIdentifiers_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_custom_data_identifiers.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDataIdentifiersDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomDataIdentifiersConfig_basic(rName, "HIGH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDataIdentifiersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_data_identifier.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ids.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_data_identifier.*", map[string]string{
+						names.AttrName:           rName + "-employee-id",
+						"regex":                  "EMP-[0-9]{6}",
+						"keywords.#":             "2",
+						"maximum_match_distance": "50",
+						"severity":               "HIGH",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_data_identifier.*", map[string]string{
+						names.AttrName: rName + "-badge-number",
+						"regex":        "BDG[0-9]{4}",
+						"keywords.#":   "1",
+						"severity":     "LOW",
+					}),
+				),
+			},
+			{
+				Config: testAccCustomDataIdentifiersConfig_basic(rName, "MEDIUM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomDataIdentifiersExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_data_identifier.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ids.%", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "custom_data_identifier.*", map[string]string{
+						names.AttrName: rName + "-employee-id",
+						"severity":     "MEDIUM",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomDataIdentifiers_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomDataIdentifiersDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCustomDataIdentifiersConfig_duplicateName(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`custom_data_identifier name \(` + rName + `\) is not unique`),
+			},
+		},
+	})
+}
+
+func testAccCheckCustomDataIdentifiersExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			name, ok := strings.CutPrefix(k, "ids.")
+			if !ok || name == "%" {
+				continue
+			}
+
+			output, err := conn.GetCustomDataIdentifierWithContext(ctx, &macie2.GetCustomDataIdentifierInput{Id: aws.String(v)})
+
+			if err != nil {
+				return err
+			}
+
+			if aws.BoolValue(output.Deleted) {
+				return fmt.Errorf("Macie Custom Data Identifier (%s) %s is deleted", name, v)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCustomDataIdentifiersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_custom_data_identifiers" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				name, ok := strings.CutPrefix(k, "ids.")
+				if !ok || name == "%" {
+					continue
+				}
+
+				output, err := conn.GetCustomDataIdentifierWithContext(ctx, &macie2.GetCustomDataIdentifierInput{Id: aws.String(v)})
+
+				if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+					tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				if !aws.BoolValue(output.Deleted) {
+					return fmt.Errorf("Macie Custom Data Identifier (%s) %s still exists", name, v)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCustomDataIdentifiersConfig_basic(rName, severity string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+locals {
+  catalog = jsondecode(<<JSON
+[
+  {
+    "name": "%[1]s-employee-id",
+    "regex": "EMP-[0-9]{6}",
+    "keywords": ["employee", "staff"],
+    "severity": %[2]q
+  },
+  {
+    "name": "%[1]s-badge-number",
+    "regex": "BDG[0-9]{4}",
+    "keywords": ["badge"],
+    "severity": "LOW"
+  }
+]
+JSON
+  )
+}
+
+resource "aws_macie2_custom_data_identifiers" "test" {
+  dynamic "custom_data_identifier" {
+    for_each = local.catalog
+
+    content {
+      name     = custom_data_identifier.value.name
+      regex    = custom_data_identifier.value.regex
+      keywords = custom_data_identifier.value.keywords
+      severity = custom_data_identifier.value.severity
+    }
+  }
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName, severity)
+}
+
+func testAccCustomDataIdentifiersConfig_duplicateName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_custom_data_identifiers" "test" {
+  custom_data_identifier {
+    name  = %[1]q
+    regex = "EMP-[0-9]{6}"
+  }
+
+  custom_data_identifier {
+    name  = %[1]q
+    regex = "BDG[0-9]{4}"
+  }
+}
+`, rName)
+}
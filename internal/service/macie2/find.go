@@ -8,6 +8,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // findMemberNotAssociated Return a list of members not associated and compare with account ID
@@ -38,3 +41,185 @@ func findMemberNotAssociated(ctx context.Context, conn *macie2.Macie2, accountID
 
 	return result, err
 }
+
+func findFindingsPublicationConfiguration(ctx context.Context, conn *macie2.Macie2) (*macie2.SecurityHubConfiguration, error) {
+	input := &macie2.GetFindingsPublicationConfigurationInput{}
+
+	output, err := conn.GetFindingsPublicationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.SecurityHubConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.SecurityHubConfiguration, nil
+}
+
+func findCustomDataIdentifierByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetCustomDataIdentifierOutput, error) {
+	input := &macie2.GetCustomDataIdentifierInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetCustomDataIdentifierWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if aws.BoolValue(output.Deleted) {
+		return nil, &retry.NotFoundError{
+			Message:     "deleted",
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+// findPendingInvitations returns the Macie membership invitations received by the account that haven't been accepted or declined.
+func findPendingInvitations(ctx context.Context, conn *macie2.Macie2) ([]*macie2.Invitation, error) {
+	input := &macie2.ListInvitationsInput{}
+	var output []*macie2.Invitation
+
+	err := conn.ListInvitationsPagesWithContext(ctx, input, func(page *macie2.ListInvitationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Invitations {
+			if v != nil && aws.StringValue(v.RelationshipStatus) == macie2.RelationshipStatusInvited {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAllowLists(ctx context.Context, conn *macie2.Macie2) ([]*macie2.AllowListSummary, error) {
+	input := &macie2.ListAllowListsInput{}
+	var output []*macie2.AllowListSummary
+
+	err := conn.ListAllowListsPagesWithContext(ctx, input, func(page *macie2.ListAllowListsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.AllowLists {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findAllowListByID(ctx context.Context, conn *macie2.Macie2, id string) (*macie2.GetAllowListOutput, error) {
+	input := &macie2.GetAllowListInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetAllowListWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findAllowListByName(ctx context.Context, conn *macie2.Macie2, name string) (*macie2.GetAllowListOutput, error) {
+	allowLists, err := findAllowLists(ctx, conn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var id string
+	for _, v := range allowLists {
+		if aws.StringValue(v.Name) == name {
+			id = aws.StringValue(v.Id)
+			break
+		}
+	}
+
+	if id == "" {
+		return nil, &retry.NotFoundError{}
+	}
+
+	return findAllowListByID(ctx, conn, id)
+}
+
+func findResourceProfileByARN(ctx context.Context, conn *macie2.Macie2, arn string) (*macie2.GetResourceProfileOutput, error) {
+	input := &macie2.GetResourceProfileInput{
+		ResourceArn: aws.String(arn),
+	}
+
+	output, err := conn.GetResourceProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
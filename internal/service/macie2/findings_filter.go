@@ -125,6 +125,14 @@ func ResourceFindingsFilter() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"recent_findings_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"recent_findings_last_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchemaForceNew(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrARN: {
@@ -219,6 +227,19 @@ func resourceFindingsFilterRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(names.AttrAction, resp.Action)
 	d.Set("position", resp.Position)
 
+	count, lastUpdatedAt, err := findRecentFindingsStatistics(ctx, conn, resp.FindingCriteria)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie FindingsFilter (%s) finding statistics: %s", d.Id(), err)
+	}
+
+	d.Set("recent_findings_count", count)
+	if lastUpdatedAt != nil {
+		d.Set("recent_findings_last_updated_at", aws.TimeValue(lastUpdatedAt).Format(time.RFC3339))
+	} else {
+		d.Set("recent_findings_last_updated_at", nil)
+	}
+
 	setTagsOut(ctx, resp.Tags)
 
 	d.Set(names.AttrARN, resp.Arn)
@@ -421,3 +442,92 @@ func flattenConditionIntField(field string, v int64) string {
 	}
 	return strconv.FormatInt(v, 10)
 }
+
+// findingsFilterStatisticsWindow is the trailing window over which the findings that match a filter are counted.
+const findingsFilterStatisticsWindow = 30 * 24 * time.Hour
+
+// findRecentFindingsStatistics returns the number of findings that match the specified criteria and were updated,
+// e.g. suppressed by the filter, within the statistics window, and when the most recent of them was updated.
+func findRecentFindingsStatistics(ctx context.Context, conn *macie2.Macie2, findingCriteria *macie2.FindingCriteria) (int64, *time.Time, error) {
+	criterion := make(map[string]*macie2.CriterionAdditionalProperties)
+
+	if findingCriteria != nil {
+		for k, v := range findingCriteria.Criterion {
+			criterion[k] = v
+		}
+	}
+
+	windowStart := time.Now().Add(-findingsFilterStatisticsWindow).UnixMilli()
+	updatedAt := &macie2.CriterionAdditionalProperties{}
+
+	if v, ok := criterion["updatedAt"]; ok && v != nil {
+		updatedAt = &macie2.CriterionAdditionalProperties{
+			Eq:           v.Eq,
+			EqExactMatch: v.EqExactMatch,
+			Gt:           v.Gt,
+			Gte:          v.Gte,
+			Lt:           v.Lt,
+			Lte:          v.Lte,
+			Neq:          v.Neq,
+		}
+	}
+
+	if aws.Int64Value(updatedAt.Gte) < windowStart {
+		updatedAt.Gte = aws.Int64(windowStart)
+	}
+
+	criterion["updatedAt"] = updatedAt
+	criteria := &macie2.FindingCriteria{
+		Criterion: criterion,
+	}
+
+	statistics, err := conn.GetFindingStatisticsWithContext(ctx, &macie2.GetFindingStatisticsInput{
+		FindingCriteria: criteria,
+		GroupBy:         aws.String(macie2.GroupBySeverityDescription),
+	})
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count int64
+
+	for _, v := range statistics.CountsByGroup {
+		count += aws.Int64Value(v.Count)
+	}
+
+	if count == 0 {
+		return 0, nil, nil
+	}
+
+	findings, err := conn.ListFindingsWithContext(ctx, &macie2.ListFindingsInput{
+		FindingCriteria: criteria,
+		MaxResults:      aws.Int64(1),
+		SortCriteria: &macie2.SortCriteria{
+			AttributeName: aws.String("updatedAt"),
+			OrderBy:       aws.String(macie2.OrderByDesc),
+		},
+	})
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(findings.FindingIds) == 0 {
+		return count, nil, nil
+	}
+
+	output, err := conn.GetFindingsWithContext(ctx, &macie2.GetFindingsInput{
+		FindingIds: findings.FindingIds,
+	})
+
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(output.Findings) == 0 {
+		return count, nil, nil
+	}
+
+	return count, output.Findings[0].UpdatedAt, nil
+}
@@ -40,6 +40,7 @@ func testAccFindingsFilter_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "terraform-"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrAction, macie2.FindingsFilterActionArchive),
 					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "macie2", regexache.MustCompile(`findings-filter/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "recent_findings_count"),
 				),
 			},
 			{
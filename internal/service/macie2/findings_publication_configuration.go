@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_macie2_findings_publication_configuration")
+func ResourceFindingsPublicationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFindingsPublicationConfigurationPut,
+		ReadWithoutTimeout:   resourceFindingsPublicationConfigurationRead,
+		UpdateWithoutTimeout: resourceFindingsPublicationConfigurationPut,
+		DeleteWithoutTimeout: resourceFindingsPublicationConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"publish_classification_findings": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"publish_policy_findings": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceFindingsPublicationConfigurationPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	input := &macie2.PutFindingsPublicationConfigurationInput{
+		SecurityHubConfiguration: &macie2.SecurityHubConfiguration{
+			PublishClassificationFindings: aws.Bool(d.Get("publish_classification_findings").(bool)),
+			PublishPolicyFindings:         aws.Bool(d.Get("publish_policy_findings").(bool)),
+		},
+	}
+
+	_, err := conn.PutFindingsPublicationConfigurationWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Macie findings publication configuration: %s", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s:%s", meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region))
+	}
+
+	return append(diags, resourceFindingsPublicationConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceFindingsPublicationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := findFindingsPublicationConfiguration(ctx, conn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie findings publication configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie findings publication configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("publish_classification_findings", output.PublishClassificationFindings)
+	d.Set("publish_policy_findings", output.PublishPolicyFindings)
+
+	return diags
+}
+
+func resourceFindingsPublicationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// Deleting the resource restores the Macie defaults: policy findings are published, classification findings are not.
+	input := &macie2.PutFindingsPublicationConfigurationInput{
+		SecurityHubConfiguration: &macie2.SecurityHubConfiguration{
+			PublishClassificationFindings: aws.Bool(false),
+			PublishPolicyFindings:         aws.Bool(true),
+		},
+	}
+
+	log.Printf("[DEBUG] Deleting Macie findings publication configuration: %s", d.Id())
+	_, err := conn.PutFindingsPublicationConfigurationWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie findings publication configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccFindingsPublicationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_findings_publication_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFindingsPublicationConfigurationDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFindingsPublicationConfigurationConfig_basic(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFindingsPublicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publish_classification_findings", "true"),
+					resource.TestCheckResourceAttr(resourceName, "publish_policy_findings", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFindingsPublicationConfigurationConfig_basic(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFindingsPublicationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publish_classification_findings", "false"),
+					resource.TestCheckResourceAttr(resourceName, "publish_policy_findings", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFindingsPublicationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_findings_publication_configuration" {
+				continue
+			}
+
+			output, err := conn.GetFindingsPublicationConfigurationWithContext(ctx, &macie2.GetFindingsPublicationConfigurationInput{})
+
+			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+				tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if v := output.SecurityHubConfiguration; v != nil && (aws.BoolValue(v.PublishClassificationFindings) || !aws.BoolValue(v.PublishPolicyFindings)) {
+				return fmt.Errorf("Macie findings publication configuration %s not restored to defaults", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFindingsPublicationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if _, ok := s.RootModule().Resources[n]; !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		_, err := conn.GetFindingsPublicationConfigurationWithContext(ctx, &macie2.GetFindingsPublicationConfigurationInput{})
+
+		return err
+	}
+}
+
+func testAccFindingsPublicationConfigurationConfig_basic(publishClassificationFindings, publishPolicyFindings bool) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_findings_publication_configuration" "test" {
+  publish_classification_findings = %[1]t
+  publish_policy_findings         = %[2]t
+
+  depends_on = [aws_macie2_account.test]
+}
+`, publishClassificationFindings, publishPolicyFindings)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_macie2_invitations")
+func DataSourceInvitations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInvitationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"administrator_account_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"invitations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"administrator_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invitation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invited_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInvitationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	invitations, err := findPendingInvitations(ctx, conn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie Invitations: %s", err)
+	}
+
+	var administratorAccountIDs []string
+	var tfList []interface{}
+
+	for _, v := range invitations {
+		administratorAccountIDs = append(administratorAccountIDs, aws.StringValue(v.AccountId))
+
+		tfMap := map[string]interface{}{
+			"administrator_account_id": aws.StringValue(v.AccountId),
+			"invitation_id":            aws.StringValue(v.InvitationId),
+		}
+
+		if v.InvitedAt != nil {
+			tfMap["invited_at"] = aws.TimeValue(v.InvitedAt).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).AccountID)
+	d.Set("administrator_account_ids", administratorAccountIDs)
+	if err := d.Set("invitations", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invitations: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccInvitationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_macie2_invitations.test"
+	email := envvar.SkipIfEmpty(t, envVarPrincipalEmail, envVarPrincipalEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvitationsDataSourceConfig_basic(email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "administrator_account_ids.*", "data.aws_caller_identity.admin", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.invitation_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "invitations.0.invited_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvitationsDataSourceConfig_basic(email string) string {
+	return acctest.ConfigAlternateAccountProvider() + fmt.Sprintf(`
+data "aws_caller_identity" "admin" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "member" {}
+
+resource "aws_macie2_account" "admin" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "member" {}
+
+resource "aws_macie2_member" "member" {
+  provider           = "awsalternate"
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = true
+  invitation_message = "This is a message of the invite"
+  depends_on         = [aws_macie2_account.admin]
+}
+
+data "aws_macie2_invitations" "test" {
+  depends_on = [aws_macie2_member.member, aws_macie2_account.member]
+}
+`, email)
+}
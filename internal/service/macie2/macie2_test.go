@@ -21,6 +21,12 @@ func TestAccMacie2_serial(t *testing.T) {
 			"finding_and_status":           testAccAccount_WithFindingAndStatus,
 			"disappears":                   testAccAccount_disappears,
 		},
+		"AllowListDataSource": {
+			"basic": testAccAllowListDataSource_basic,
+		},
+		"AllowListsDataSource": {
+			"basic": testAccAllowListsDataSource_basic,
+		},
 		"ClassificationExportConfiguration": {
 			"basic": testAccClassificationExportConfiguration_basic,
 		},
@@ -33,6 +39,7 @@ func TestAccMacie2_serial(t *testing.T) {
 			"complete":           testAccClassificationJob_complete,
 			names.AttrTags:       testAccClassificationJob_WithTags,
 			"bucket_criteria":    testAccClassificationJob_BucketCriteria,
+			"schedule_frequency": testAccClassificationJob_ScheduleFrequency,
 		},
 		"CustomDataIdentifier": {
 			"basic":              testAccCustomDataIdentifier_basic,
@@ -42,6 +49,10 @@ func TestAccMacie2_serial(t *testing.T) {
 			"classification_job": testAccCustomDataIdentifier_WithClassificationJob,
 			names.AttrTags:       testAccCustomDataIdentifier_WithTags,
 		},
+		"CustomDataIdentifiers": {
+			"basic":          testAccCustomDataIdentifiers_basic,
+			"duplicate_name": testAccCustomDataIdentifiers_duplicateName,
+		},
 		"FindingsFilter": {
 			"basic":              testAccFindingsFilter_basic,
 			"name_generated":     testAccFindingsFilter_Name_Generated,
@@ -52,22 +63,34 @@ func TestAccMacie2_serial(t *testing.T) {
 			"number":             testAccFindingsFilter_WithNumber,
 			names.AttrTags:       testAccFindingsFilter_withTags,
 		},
+		"FindingsPublicationConfiguration": {
+			"basic": testAccFindingsPublicationConfiguration_basic,
+		},
+		"ResourceProfile": {
+			"basic": testAccResourceProfile_basic,
+		},
 		"OrganizationAdminAccount": {
 			"basic":      testAccOrganizationAdminAccount_basic,
 			"disappears": testAccOrganizationAdminAccount_disappears,
+			"regions":    testAccOrganizationAdminAccount_regions,
 		},
 		"Member": {
 			"basic":                                 testAccMember_basic,
 			"disappears":                            testAccMember_disappears,
 			names.AttrTags:                          testAccMember_withTags,
 			"invitation_disable_email_notification": testAccMember_invitationDisableEmailNotification,
+			"invitation_message":                    testAccMember_invitationMessage,
 			"invite":                                testAccMember_invite,
 			"invite_removed":                        testAccMember_inviteRemoved,
+			"organization":                          testAccMember_organization,
 			names.AttrStatus:                        testAccMember_status,
 		},
 		"InvitationAccepter": {
 			"basic": testAccInvitationAccepter_basic,
 		},
+		"InvitationsDataSource": {
+			"basic": testAccInvitationsDataSource_basic,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -5,6 +5,7 @@ package macie2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -140,50 +141,25 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return append(diags, resourceMemberRead(ctx, d, meta)...)
 	}
 
-	// Invitation workflow
-
-	inputInvite := &macie2.CreateInvitationsInput{
-		AccountIds: []*string{aws.String(d.Id())},
-	}
-
-	if v, ok := d.GetOk("invitation_disable_email_notification"); ok {
-		inputInvite.DisableEmailNotification = aws.Bool(v.(bool))
-	}
-	if v, ok := d.GetOk("invitation_message"); ok {
-		inputInvite.Message = aws.String(v.(string))
-	}
-
-	log.Printf("[INFO] Inviting Macie2 Member: %s", inputInvite)
-
-	var output *macie2.CreateInvitationsOutput
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
-		output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
-
-		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
-			return retry.RetryableError(err)
-		}
-
-		if err != nil {
-			return retry.NonRetryableError(err)
-		}
-
-		return nil
+	// When the administrator account is the organization's delegated administrator, members
+	// that belong to the organization are associated on creation and can't be invited.
+	member, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+		Id: aws.String(d.Id()),
 	})
 
-	if tfresource.TimedOut(err) {
-		output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
-	}
-
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "inviting Macie Member: %s", err)
+		return sdkdiag.AppendErrorf(diags, "reading Macie Member (%s): %s", d.Id(), err)
 	}
 
-	if len(output.UnprocessedAccounts) != 0 {
-		return sdkdiag.AppendErrorf(diags, "inviting Macie Member: %s: %s", aws.StringValue(output.UnprocessedAccounts[0].ErrorCode), aws.StringValue(output.UnprocessedAccounts[0].ErrorMessage))
+	if memberAssociatedByOrganization(member) {
+		log.Printf("[INFO] Macie Member (%s) is associated by organization, skipping invitation", d.Id())
+		return append(diags, resourceMemberRead(ctx, d, meta)...)
 	}
 
-	if _, err = waitMemberInvited(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for Macie Member (%s) invitation: %s", d.Id(), err)
+	// Invitation workflow
+
+	if err := inviteMember(ctx, conn, d); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	return append(diags, resourceMemberRead(ctx, d, meta)...)
@@ -256,48 +232,21 @@ func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	if d.HasChange("invite") {
 		if d.Get("invite").(bool) {
-			inputInvite := &macie2.CreateInvitationsInput{
-				AccountIds: []*string{aws.String(d.Id())},
-			}
-
-			if v, ok := d.GetOk("invitation_disable_email_notification"); ok {
-				inputInvite.DisableEmailNotification = aws.Bool(v.(bool))
-			}
-			if v, ok := d.GetOk("invitation_message"); ok {
-				inputInvite.Message = aws.String(v.(string))
-			}
-
-			log.Printf("[INFO] Inviting Macie2 Member: %s", inputInvite)
-			var output *macie2.CreateInvitationsOutput
-			var err error
-			err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
-				output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
-
-				if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
-					return retry.RetryableError(err)
-				}
-
-				if err != nil {
-					return retry.NonRetryableError(err)
-				}
-
-				return nil
+			member, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+				Id: aws.String(d.Id()),
 			})
 
-			if tfresource.TimedOut(err) {
-				output, err = conn.CreateInvitationsWithContext(ctx, inputInvite)
-			}
-
 			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "inviting Macie Member: %s", err)
+				return sdkdiag.AppendErrorf(diags, "reading Macie Member (%s): %s", d.Id(), err)
 			}
 
-			if len(output.UnprocessedAccounts) != 0 {
-				return sdkdiag.AppendErrorf(diags, "inviting Macie Member: %s: %s", aws.StringValue(output.UnprocessedAccounts[0].ErrorCode), aws.StringValue(output.UnprocessedAccounts[0].ErrorMessage))
+			if memberAssociatedByOrganization(member) {
+				log.Printf("[INFO] Macie Member (%s) is associated by organization, skipping invitation", d.Id())
+				return append(diags, resourceMemberRead(ctx, d, meta)...)
 			}
 
-			if _, err = waitMemberInvited(ctx, conn, d.Id()); err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for Macie Member (%s) invitation: %s", d.Id(), err)
+			if err := inviteMember(ctx, conn, d); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
 			}
 		} else {
 			input := &macie2.DisassociateMemberInput{
@@ -313,6 +262,22 @@ func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 				return sdkdiag.AppendErrorf(diags, "disassociating Macie Member invite (%s): %s", d.Id(), err)
 			}
 		}
+	} else if d.HasChanges("invitation_disable_email_notification", "invitation_message") && d.Get("invite").(bool) {
+		// An invitation that hasn't been accepted yet is sent again with the new message.
+		// Once accepted, the invitation settings have no further effect and are only recorded in state.
+		member, err := conn.GetMemberWithContext(ctx, &macie2.GetMemberInput{
+			Id: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Macie Member (%s): %s", d.Id(), err)
+		}
+
+		if memberInvitationPending(member) {
+			if err := inviteMember(ctx, conn, d); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
 	}
 
 	// End Invitation workflow
@@ -353,3 +318,74 @@ func resourceMemberDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	return diags
 }
+
+// memberAssociatedByOrganization returns whether the member was associated through AWS Organizations
+// rather than by invitation. Members that are invited aren't enabled until they accept the invitation,
+// whereas organization members are enabled as soon as they're created.
+func memberAssociatedByOrganization(member *macie2.GetMemberOutput) bool {
+	switch aws.StringValue(member.RelationshipStatus) {
+	case macie2.RelationshipStatusEnabled, macie2.RelationshipStatusPaused:
+		return true
+	default:
+		return false
+	}
+}
+
+// memberInvitationPending returns whether the member has been invited but hasn't yet accepted the invitation.
+func memberInvitationPending(member *macie2.GetMemberOutput) bool {
+	switch aws.StringValue(member.RelationshipStatus) {
+	case macie2.RelationshipStatusInvited, macie2.RelationshipStatusEmailVerificationInProgress:
+		return true
+	default:
+		return false
+	}
+}
+
+func inviteMember(ctx context.Context, conn *macie2.Macie2, d *schema.ResourceData) error {
+	input := &macie2.CreateInvitationsInput{
+		AccountIds: []*string{aws.String(d.Id())},
+	}
+
+	if v, ok := d.GetOk("invitation_disable_email_notification"); ok {
+		input.DisableEmailNotification = aws.Bool(v.(bool))
+	}
+	if v, ok := d.GetOk("invitation_message"); ok {
+		input.Message = aws.String(v.(string))
+	}
+
+	log.Printf("[INFO] Inviting Macie2 Member: %s", input)
+
+	var output *macie2.CreateInvitationsOutput
+	err := retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+		var err error
+		output, err = conn.CreateInvitationsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		output, err = conn.CreateInvitationsWithContext(ctx, input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("inviting Macie Member: %w", err)
+	}
+
+	if len(output.UnprocessedAccounts) != 0 {
+		return fmt.Errorf("inviting Macie Member: %s: %s", aws.StringValue(output.UnprocessedAccounts[0].ErrorCode), aws.StringValue(output.UnprocessedAccounts[0].ErrorMessage))
+	}
+
+	if _, err := waitMemberInvited(ctx, conn, d.Id()); err != nil {
+		return fmt.Errorf("waiting for Macie Member (%s) invitation: %w", d.Id(), err)
+	}
+
+	return nil
+}
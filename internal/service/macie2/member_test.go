@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -189,6 +190,83 @@ func testAccMember_invite(t *testing.T) {
 	})
 }
 
+func testAccMember_invitationMessage(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	email := envvar.SkipIfEmpty(t, envVarAlternateEmail, envVarAlternateEmailMessageError)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckInvitationAccepterDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfig_invitationMessage(email, "This is a message of the invitation"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+					resource.TestCheckResourceAttr(resourceName, "invitation_message", "This is a message of the invitation"),
+				),
+			},
+			{
+				Config: testAccMemberConfig_invitationMessage(email, "This is an updated message of the invitation"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusInvited),
+					resource.TestCheckResourceAttr(resourceName, "invitation_message", "This is an updated message of the invitation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMember_organization(t *testing.T) {
+	ctx := acctest.Context(t)
+	var macie2Output macie2.GetMemberOutput
+	resourceName := "aws_macie2_member.member"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckMemberDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemberConfig_organization(acctest.DefaultEmailAddress),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMemberExists(ctx, resourceName, &macie2Output),
+					resource.TestCheckResourceAttr(resourceName, "relationship_status", macie2.RelationshipStatusEnabled),
+					resource.TestCheckResourceAttr(resourceName, "invite", "true"),
+					acctest.CheckResourceAttrAccountID(resourceName, "administrator_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrAccountID, dataSourceAlternate, names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, macie2.MacieStatusEnabled),
+				),
+			},
+			{
+				Config:            testAccMemberConfig_organization(acctest.DefaultEmailAddress),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccMember_inviteRemoved(t *testing.T) {
 	ctx := acctest.Context(t)
 	var macie2Output macie2.GetMemberOutput
@@ -455,6 +533,54 @@ resource "aws_macie2_member" "member" {
 `, email, invite)
 }
 
+func testAccMemberConfig_invitationMessage(email, message string) string {
+	return acctest.ConfigAlternateAccountProvider() + fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_account" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_macie2_member" "member" {
+  account_id         = data.aws_caller_identity.member.account_id
+  email              = %[1]q
+  invite             = true
+  invitation_message = %[2]q
+  depends_on         = [aws_macie2_account.admin]
+}
+`, email, message)
+}
+
+func testAccMemberConfig_organization(email string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateAccountProvider(),
+		fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+data "aws_caller_identity" "admin" {}
+
+resource "aws_macie2_account" "admin" {}
+
+resource "aws_macie2_organization_admin_account" "admin" {
+  admin_account_id = data.aws_caller_identity.admin.account_id
+  depends_on       = [aws_macie2_account.admin]
+}
+
+resource "aws_macie2_member" "member" {
+  account_id = data.aws_caller_identity.member.account_id
+  email      = %[1]q
+  invite     = true
+  depends_on = [aws_macie2_organization_admin_account.admin]
+}
+`, email))
+}
+
 func testAccMemberConfig_inviteInvitationDisableEmailNotification(email, disable string, invite bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAlternateAccountProvider(),
@@ -6,6 +6,7 @@ package macie2
 import (
 	"context"
 	"log"
+	"slices"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,7 +18,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_macie2_organization_admin_account")
@@ -25,6 +28,7 @@ func ResourceOrganizationAdminAccount() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceOrganizationAdminAccountCreate,
 		ReadWithoutTimeout:   resourceOrganizationAdminAccountRead,
+		UpdateWithoutTimeout: resourceOrganizationAdminAccountUpdate,
 		DeleteWithoutTimeout: resourceOrganizationAdminAccountDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -35,6 +39,14 @@ func ResourceOrganizationAdminAccount() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"regions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidRegionName,
+				},
+			},
 		},
 	}
 }
@@ -42,92 +54,171 @@ func ResourceOrganizationAdminAccount() *schema.Resource {
 func resourceOrganizationAdminAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
 	adminAccountID := d.Get("admin_account_id").(string)
-	input := &macie2.EnableOrganizationAdminAccountInput{
-		AdminAccountId: aws.String(adminAccountID),
-		ClientToken:    aws.String(id.UniqueId()),
+
+	for _, region := range organizationAdminAccountRegions(d.Get("regions").(*schema.Set), meta.(*conns.AWSClient).Region) {
+		conn := meta.(*conns.AWSClient).Macie2ConnForRegion(ctx, region)
+
+		if err := enableOrganizationAdminAccount(ctx, conn, adminAccountID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Macie OrganizationAdminAccount (%s): %s", region, err)
+		}
+
+		// Set the ID as soon as the first region is registered so that registered regions are destroyed on failure.
+		d.SetId(adminAccountID)
 	}
 
-	var err error
-	err = retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
-		_, err := conn.EnableOrganizationAdminAccountWithContext(ctx, input)
+	return append(diags, resourceOrganizationAdminAccountRead(ctx, d, meta)...)
+}
 
-		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
-			return retry.RetryableError(err)
+func resourceOrganizationAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var regions []string
+
+	for _, region := range organizationAdminAccountRegions(d.Get("regions").(*schema.Set), meta.(*conns.AWSClient).Region) {
+		conn := meta.(*conns.AWSClient).Macie2ConnForRegion(ctx, region)
+
+		res, err := GetOrganizationAdminAccount(ctx, conn, d.Id())
+
+		if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
+			log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found in %s", d.Id(), region)
+			continue
 		}
 
 		if err != nil {
-			return retry.NonRetryableError(err)
+			return sdkdiag.AppendErrorf(diags, "reading Macie OrganizationAdminAccount (%s): %s", d.Id(), err)
 		}
 
-		return nil
-	})
+		if res == nil {
+			if !d.IsNewResource() {
+				log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found in %s", d.Id(), region)
+				continue
+			}
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.EnableOrganizationAdminAccountWithContext(ctx, input)
+			return sdkdiag.AppendFromErr(diags, &retry.NotFoundError{})
+		}
+
+		regions = append(regions, region)
 	}
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating Macie OrganizationAdminAccount: %s", err)
+	if len(regions) == 0 {
+		log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
 	}
 
-	d.SetId(adminAccountID)
+	d.Set("admin_account_id", d.Id())
+	if d.Get("regions").(*schema.Set).Len() > 0 {
+		d.Set("regions", regions)
+	}
 
-	return append(diags, resourceOrganizationAdminAccountRead(ctx, d, meta)...)
+	return diags
 }
 
-func resourceOrganizationAdminAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceOrganizationAdminAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+	if d.HasChange("regions") {
+		o, n := d.GetChange("regions")
+		defaultRegion := meta.(*conns.AWSClient).Region
+		oldRegions := organizationAdminAccountRegions(o.(*schema.Set), defaultRegion)
+		newRegions := organizationAdminAccountRegions(n.(*schema.Set), defaultRegion)
 
-	res, err := GetOrganizationAdminAccount(ctx, conn, d.Id())
+		for _, region := range newRegions {
+			if slices.Contains(oldRegions, region) {
+				continue
+			}
 
-	if !d.IsNewResource() && (tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled")) {
-		log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return diags
-	}
+			conn := meta.(*conns.AWSClient).Macie2ConnForRegion(ctx, region)
+
+			if err := enableOrganizationAdminAccount(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Macie OrganizationAdminAccount (%s): enabling in %s: %s", d.Id(), region, err)
+			}
+		}
+
+		for _, region := range oldRegions {
+			if slices.Contains(newRegions, region) {
+				continue
+			}
+
+			conn := meta.(*conns.AWSClient).Macie2ConnForRegion(ctx, region)
 
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Macie OrganizationAdminAccount (%s): %s", d.Id(), err)
+			if err := disableOrganizationAdminAccount(ctx, conn, d.Id()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Macie OrganizationAdminAccount (%s): disabling in %s: %s", d.Id(), region, err)
+			}
+		}
 	}
 
-	if res == nil {
-		if !d.IsNewResource() {
-			log.Printf("[WARN] Macie OrganizationAdminAccount (%s) not found, removing from state", d.Id())
-			d.SetId("")
-			return diags
+	return append(diags, resourceOrganizationAdminAccountRead(ctx, d, meta)...)
+}
+
+func resourceOrganizationAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, region := range organizationAdminAccountRegions(d.Get("regions").(*schema.Set), meta.(*conns.AWSClient).Region) {
+		conn := meta.(*conns.AWSClient).Macie2ConnForRegion(ctx, region)
+
+		if err := disableOrganizationAdminAccount(ctx, conn, d.Id()); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "deleting Macie OrganizationAdminAccount (%s) in %s: %s", d.Id(), region, err)
 		}
+	}
 
-		return sdkdiag.AppendFromErr(diags, &retry.NotFoundError{})
+	return diags
+}
+
+// organizationAdminAccountRegions returns the configured regions in order, or the default region if none are configured.
+func organizationAdminAccountRegions(s *schema.Set, defaultRegion string) []string {
+	if s.Len() == 0 {
+		return []string{defaultRegion}
 	}
 
-	d.Set("admin_account_id", res.AccountId)
+	regions := flex.ExpandStringValueSet(s)
+	slices.Sort(regions)
 
-	return diags
+	return regions
 }
 
-func resourceOrganizationAdminAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+func enableOrganizationAdminAccount(ctx context.Context, conn *macie2.Macie2, adminAccountID string) error {
+	input := &macie2.EnableOrganizationAdminAccountInput{
+		AdminAccountId: aws.String(adminAccountID),
+		ClientToken:    aws.String(id.UniqueId()),
+	}
+
+	err := retry.RetryContext(ctx, 4*time.Minute, func() *retry.RetryError {
+		_, err := conn.EnableOrganizationAdminAccountWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, macie2.ErrorCodeClientError) {
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
 
-	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+		return nil
+	})
 
+	if tfresource.TimedOut(err) {
+		_, err = conn.EnableOrganizationAdminAccountWithContext(ctx, input)
+	}
+
+	return err
+}
+
+func disableOrganizationAdminAccount(ctx context.Context, conn *macie2.Macie2, adminAccountID string) error {
 	input := &macie2.DisableOrganizationAdminAccountInput{
-		AdminAccountId: aws.String(d.Id()),
+		AdminAccountId: aws.String(adminAccountID),
 	}
 
 	_, err := conn.DisableOrganizationAdminAccountWithContext(ctx, input)
-	if err != nil {
-		if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
-			tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
-			return diags
-		}
-		return sdkdiag.AppendErrorf(diags, "deleting Macie OrganizationAdminAccount (%s): %s", d.Id(), err)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return nil
 	}
-	return diags
+
+	return err
 }
 
 func GetOrganizationAdminAccount(ctx context.Context, conn *macie2.Macie2, adminAccountID string) (*macie2.AdminAccount, error) {
@@ -6,6 +6,7 @@ package macie2_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/macie2"
@@ -71,6 +72,47 @@ func testAccOrganizationAdminAccount_disappears(t *testing.T) {
 	})
 }
 
+func testAccOrganizationAdminAccount_regions(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_macie2_organization_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsAccount(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckOrganizationAdminAccountDestroy(ctx),
+		ErrorCheck:               testAccErrorCheckSkipOrganizationAdminAccount(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationAdminAccountConfig_regions(acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAdminAccountExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+				),
+			},
+			{
+				Config: testAccOrganizationAdminAccountConfig_regions(acctest.Region(), acctest.AlternateRegion()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrganizationAdminAccountExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"regions"},
+			},
+		},
+	})
+}
+
 func testAccErrorCheckSkipOrganizationAdminAccount(t *testing.T) resource.ErrorCheckFunc {
 	return acctest.ErrorCheckSkipMessagesContaining(t,
 		"AccessDeniedException: The request failed because you must be a user of the management account for your AWS organization to perform this operation",
@@ -150,3 +192,28 @@ resource "aws_macie2_organization_admin_account" "test" {
 }
 `
 }
+
+func testAccOrganizationAdminAccountConfig_regions(regions ...string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_macie2_account" "test" {}
+
+resource "aws_macie2_account" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_organizations_organization" "test" {
+  aws_service_access_principals = ["macie.${data.aws_partition.current.dns_suffix}"]
+  feature_set                   = "ALL"
+}
+
+resource "aws_macie2_organization_admin_account" "test" {
+  admin_account_id = data.aws_caller_identity.current.account_id
+  regions          = ["%[1]s"]
+  depends_on       = [aws_macie2_account.test, aws_macie2_account.alternate, aws_organizations_organization.test]
+}
+`, strings.Join(regions, `", "`)))
+}
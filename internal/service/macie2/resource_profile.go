@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_macie2_resource_profile")
+func ResourceResourceProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceResourceProfilePut,
+		ReadWithoutTimeout:   resourceResourceProfileRead,
+		UpdateWithoutTimeout: resourceResourceProfilePut,
+		DeleteWithoutTimeout: resourceResourceProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sensitivity_score": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sensitivity_score_override": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      maximumSensitivityScore,
+				ValidateFunc: validation.IntInSlice([]int{maximumSensitivityScore}),
+			},
+		},
+	}
+}
+
+func resourceResourceProfilePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	arn := d.Get(names.AttrResourceARN).(string)
+	input := &macie2.UpdateResourceProfileInput{
+		ResourceArn:              aws.String(arn),
+		SensitivityScoreOverride: aws.Int64(int64(d.Get("sensitivity_score_override").(int))),
+	}
+
+	_, err := conn.UpdateResourceProfileWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Macie resource profile (%s): %s", arn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(arn)
+	}
+
+	return append(diags, resourceResourceProfileRead(ctx, d, meta)...)
+}
+
+func resourceResourceProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	output, err := findResourceProfileByARN(ctx, conn, d.Id())
+
+	if err == nil && !aws.BoolValue(output.SensitivityScoreOverridden) {
+		// The sensitivity score is calculated by Macie again, i.e. the override has been removed.
+		err = &retry.NotFoundError{
+			Message: "sensitivity score not overridden",
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Macie resource profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Macie resource profile (%s): %s", d.Id(), err)
+	}
+
+	if v := output.ProfileUpdatedAt; v != nil {
+		d.Set("profile_updated_at", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("profile_updated_at", nil)
+	}
+	d.Set(names.AttrResourceARN, d.Id())
+	d.Set("sensitivity_score", output.SensitivityScore)
+	d.Set("sensitivity_score_override", output.SensitivityScore)
+
+	return diags
+}
+
+func resourceResourceProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Macie2Conn(ctx)
+
+	// Omitting the override reverts to the sensitivity score calculated by Macie.
+	input := &macie2.UpdateResourceProfileInput{
+		ResourceArn: aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Deleting Macie resource profile sensitivity score override: %s", d.Id())
+	_, err := conn.UpdateResourceProfileWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+		tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Macie resource profile (%s) sensitivity score override: %s", d.Id(), err)
+	}
+
+	return diags
+}
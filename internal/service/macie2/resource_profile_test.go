@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccResourceProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_macie2_resource_profile.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceProfileDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.Macie2ServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceProfileConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceProfileExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, bucketResourceName, names.AttrARN),
+					acctest.CheckResourceAttrRFC3339(resourceName, "profile_updated_at"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, bucketResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_score", "100"),
+					resource.TestCheckResourceAttr(resourceName, "sensitivity_score_override", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckResourceProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_macie2_resource_profile" {
+				continue
+			}
+
+			output, err := conn.GetResourceProfileWithContext(ctx, &macie2.GetResourceProfileInput{
+				ResourceArn: aws.String(rs.Primary.ID),
+			})
+
+			if tfawserr.ErrCodeEquals(err, macie2.ErrCodeResourceNotFoundException) ||
+				tfawserr.ErrMessageContains(err, macie2.ErrCodeAccessDeniedException, "Macie is not enabled") {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if aws.BoolValue(output.SensitivityScoreOverridden) {
+				return fmt.Errorf("Macie resource profile %s sensitivity score still overridden", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceProfileExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Macie2Conn(ctx)
+
+		output, err := conn.GetResourceProfileWithContext(ctx, &macie2.GetResourceProfileInput{
+			ResourceArn: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if !aws.BoolValue(output.SensitivityScoreOverridden) {
+			return fmt.Errorf("Macie resource profile %s sensitivity score not overridden", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceProfileConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_macie2_account" "test" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_macie2_resource_profile" "test" {
+  resource_arn = aws_s3_bucket.test.arn
+
+  depends_on = [aws_macie2_account.test]
+}
+`, rName)
+}
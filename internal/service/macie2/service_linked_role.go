@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package macie2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	serviceLinkedRoleName        = "AWSServiceRoleForAmazonMacie"
+	serviceLinkedRoleServiceName = "macie.amazonaws.com"
+)
+
+// ensureServiceLinkedRole creates Macie's service-linked role if it doesn't exist yet.
+// Macie creates the role itself when it's enabled, but fails with an opaque error if the role can't be created,
+// e.g. because a service control policy (SCP) denies iam:CreateServiceLinkedRole.
+// If the role can't be read, the check is skipped and Macie's own error is returned when it's enabled.
+func ensureServiceLinkedRole(ctx context.Context, conn *iam.Client) error {
+	_, err := conn.GetRole(ctx, &iam.GetRoleInput{
+		RoleName: aws.String(serviceLinkedRoleName),
+	})
+
+	if err == nil {
+		return nil
+	}
+
+	if !errs.IsA[*awstypes.NoSuchEntityException](err) {
+		log.Printf("[WARN] Unable to read Macie service-linked role (%s), skipping check: %s", serviceLinkedRoleName, err)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Creating Macie service-linked role: %s", serviceLinkedRoleName)
+	_, err = conn.CreateServiceLinkedRole(ctx, &iam.CreateServiceLinkedRoleInput{
+		AWSServiceName: aws.String(serviceLinkedRoleServiceName),
+	})
+
+	// The role was created concurrently, e.g. by Macie.
+	if errs.IsAErrorMessageContains[*awstypes.InvalidInputException](err, "has been taken in this account") {
+		return nil
+	}
+
+	if tfawserr.ErrCodeEquals(err, "AccessDenied", "AccessDeniedException") {
+		return fmt.Errorf("Macie requires the service-linked role %s, which doesn't exist and can't be created. "+
+			"Check that no IAM policy or service control policy (SCP) denies iam:CreateServiceLinkedRole for %s: %w", serviceLinkedRoleName, serviceLinkedRoleServiceName, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("creating Macie service-linked role (%s): %w", serviceLinkedRoleName, err)
+	}
+
+	return nil
+}
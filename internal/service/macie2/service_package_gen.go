@@ -24,7 +24,20 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  DataSourceAllowList,
+			TypeName: "aws_macie2_allow_list",
+		},
+		{
+			Factory:  DataSourceAllowLists,
+			TypeName: "aws_macie2_allow_lists",
+		},
+		{
+			Factory:  DataSourceInvitations,
+			TypeName: "aws_macie2_invitations",
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -49,12 +62,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Custom Data Identifier",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceCustomDataIdentifiers,
+			TypeName: "aws_macie2_custom_data_identifiers",
+			Name:     "Custom Data Identifiers",
+		},
 		{
 			Factory:  ResourceFindingsFilter,
 			TypeName: "aws_macie2_findings_filter",
 			Name:     "Findings Filter",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceFindingsPublicationConfiguration,
+			TypeName: "aws_macie2_findings_publication_configuration",
+		},
 		{
 			Factory:  ResourceInvitationAccepter,
 			TypeName: "aws_macie2_invitation_accepter",
@@ -69,6 +91,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceOrganizationAdminAccount,
 			TypeName: "aws_macie2_organization_admin_account",
 		},
+		{
+			Factory:  ResourceResourceProfile,
+			TypeName: "aws_macie2_resource_profile",
+		},
 	}
 }
 
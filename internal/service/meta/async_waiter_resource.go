@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// asyncWaiters returns the waiters for resources created with async_create, keyed by resource type.
+// Each service package that supports async_create provides its own waiters.
+func asyncWaiters(ctx context.Context, client *conns.AWSClient) map[string]conns.AsyncWaiter {
+	waiters := make(map[string]conns.AsyncWaiter)
+
+	for _, sp := range client.ServicePackages {
+		if v, ok := sp.(interface {
+			AsyncWaiters(context.Context) map[string]conns.AsyncWaiter
+		}); ok {
+			maps.Copy(waiters, v.AsyncWaiters(ctx))
+		}
+	}
+
+	return waiters
+}
+
+// @FrameworkResource(name="Async Waiter")
+func newAsyncWaiterResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &asyncWaiterResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type asyncWaiterResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*asyncWaiterResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_async_waiter"
+}
+
+func (r *asyncWaiterResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"resource_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan validates resource_type. The supported resource types are only known once the provider is configured.
+func (r *asyncWaiterResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() || r.Meta() == nil {
+		return
+	}
+
+	var resourceType types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrResourceType), &resourceType)...)
+	if response.Diagnostics.HasError() || resourceType.IsUnknown() {
+		return
+	}
+
+	waiters := asyncWaiters(ctx, r.Meta())
+	if _, ok := waiters[resourceType.ValueString()]; !ok {
+		resourceTypes := make([]string, 0, len(waiters))
+		for k := range waiters {
+			resourceTypes = append(resourceTypes, k)
+		}
+		sort.Strings(resourceTypes)
+
+		response.Diagnostics.AddAttributeError(
+			path.Root(names.AttrResourceType),
+			"Invalid Attribute Value",
+			fmt.Sprintf("resource_type must be one of %q, got: %q", resourceTypes, resourceType.ValueString()),
+		)
+	}
+}
+
+func (r *asyncWaiterResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data asyncWaiterResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceType, resourceID := data.ResourceType.ValueString(), data.ResourceID.ValueString()
+
+	waiter, ok := asyncWaiters(ctx, r.Meta())[resourceType]
+	if !ok {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for %s (%s) create", resourceType, resourceID), "unsupported resource type")
+
+		return
+	}
+
+	if err := waiter(ctx, r.Meta(), resourceID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for %s (%s) create", resourceType, resourceID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(resourceID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+// Read is a no-op. The waited-for resource is managed, and refreshed, by its own resource.
+func (r *asyncWaiterResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data asyncWaiterResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type asyncWaiterResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ResourceID   types.String   `tfsdk:"resource_id"`
+	ResourceType types.String   `tfsdk:"resource_type"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
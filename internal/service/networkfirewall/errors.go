@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+const (
+	errCodeAccessDenied          = "AccessDenied"
+	errCodeUnauthorizedOperation = "UnauthorizedOperation"
+)
@@ -0,0 +1,12 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+// Exports for use in tests only.
+var (
+	CheckSubnetsAvailableIPAddresses = checkSubnetsAvailableIPAddresses
+	DuplicateCustomActionNames       = duplicateCustomActionNames
+	FirewallPolicySyncState          = firewallPolicySyncState
+	SubnetMappingsToAssociate        = subnetMappingsToAssociate
+)
@@ -8,6 +8,8 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // FindLoggingConfiguration returns the LoggingConfigurationOutput from a call to DescribeLoggingConfigurationWithContext
@@ -58,3 +60,89 @@ func FindResourcePolicy(ctx context.Context, conn *networkfirewall.NetworkFirewa
 	}
 	return output.Policy, nil
 }
+
+func findFirewallPolicies(ctx context.Context, conn *networkfirewall.NetworkFirewall, input *networkfirewall.ListFirewallPoliciesInput) ([]*networkfirewall.FirewallPolicyMetadata, error) {
+	var output []*networkfirewall.FirewallPolicyMetadata
+
+	err := conn.ListFirewallPoliciesPagesWithContext(ctx, input, func(page *networkfirewall.ListFirewallPoliciesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.FirewallPolicies {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findRuleGroups(ctx context.Context, conn *networkfirewall.NetworkFirewall, input *networkfirewall.ListRuleGroupsInput) ([]*networkfirewall.RuleGroupMetadata, error) {
+	var output []*networkfirewall.RuleGroupMetadata
+
+	err := conn.ListRuleGroupsPagesWithContext(ctx, input, func(page *networkfirewall.ListRuleGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.RuleGroups {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findFirewallMetadataByVPCID(ctx context.Context, conn *networkfirewall.NetworkFirewall, vpcID string) (*networkfirewall.FirewallMetadata, error) {
+	input := &networkfirewall.ListFirewallsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	}
+
+	output, err := findFirewallMetadatas(ctx, conn, input, tfslices.PredicateTrue[*networkfirewall.FirewallMetadata]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findFirewallMetadatas(ctx context.Context, conn *networkfirewall.NetworkFirewall, input *networkfirewall.ListFirewallsInput, filter tfslices.Predicate[*networkfirewall.FirewallMetadata]) ([]*networkfirewall.FirewallMetadata, error) {
+	var output []*networkfirewall.FirewallMetadata
+
+	err := conn.ListFirewallsPagesWithContext(ctx, input, func(page *networkfirewall.ListFirewallsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Firewalls {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -6,11 +6,15 @@ package networkfirewall
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -50,6 +56,7 @@ func ResourceFirewall() *schema.Resource {
 			customdiff.ComputedIf("firewall_status", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("subnet_mapping")
 			}),
+			resourceFirewallSubnetCapacityCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -58,6 +65,10 @@ func ResourceFirewall() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"async_create": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"delete_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -100,6 +111,10 @@ func ResourceFirewall() *schema.Resource {
 													Type:     schema.TypeString,
 													Computed: true,
 												},
+												names.AttrNetworkInterfaceID: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
 												names.AttrSubnetID: {
 													Type:     schema.TypeString,
 													Computed: true,
@@ -127,6 +142,11 @@ func ResourceFirewall() *schema.Resource {
 				Required: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
 						"ip_address_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -155,6 +175,42 @@ func ResourceFirewall() *schema.Resource {
 	}
 }
 
+const (
+	// firewallEndpointIPAddressCount is the number of IPv4 addresses used by a firewall endpoint in its subnet.
+	firewallEndpointIPAddressCount = 1
+)
+
+// resourceFirewallSubnetCapacityCustomizeDiff fails the plan if a subnet being associated with the firewall
+// has no available IPv4 address for the firewall endpoint, which would otherwise only fail its provisioning.
+func resourceFirewallSubnetCapacityCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("subnet_mapping") || !diff.GetRawPlan().GetAttr("subnet_mapping").IsWhollyKnown() {
+		return nil
+	}
+
+	o, n := diff.GetChange("subnet_mapping")
+	subnetIDs := subnetMappingsToAssociate(o.(*schema.Set), n.(*schema.Set))
+
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+
+	subnets, err := tfec2.FindSubnets(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	})
+
+	// The check is best effort: subnets that can't be read are reported when the firewall is created or updated.
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeUnauthorizedOperation) {
+		log.Printf("[WARN] Skipping available IP address check of EC2 Subnets (%s): %s", strings.Join(subnetIDs, ", "), err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Subnets (%s): %w", strings.Join(subnetIDs, ", "), err)
+	}
+
+	return checkSubnetsAvailableIPAddresses(subnets)
+}
+
 func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -197,8 +253,10 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.StringValue(output.Firewall.FirewallArn))
 
-	if _, err := waitFirewallCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) create: %s", d.Id(), err)
+	if !d.Get("async_create").(bool) {
+		if _, err := waitFirewallCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) create: %s", d.Id(), err)
+		}
 	}
 
 	return append(diags, resourceFirewallRead(ctx, d, meta)...)
@@ -230,12 +288,26 @@ func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("firewall_policy_arn", firewall.FirewallPolicyArn)
 	d.Set("firewall_policy_change_protection", firewall.FirewallPolicyChangeProtection)
-	if err := d.Set("firewall_status", flattenFirewallStatus(output.FirewallStatus)); err != nil {
+	networkInterfaceIDs, err := findFirewallEndpointNetworkInterfaceIDs(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), output.FirewallStatus)
+	switch {
+	case isFirewallEndpointNetworkInterfaceIDsUnavailable(err):
+		diags = sdkdiag.AppendWarningf(diags, "reading NetworkFirewall Firewall (%s) endpoint network interfaces: %s", d.Id(), err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall (%s) endpoint network interfaces: %s", d.Id(), err)
+	}
+	if err := d.Set("firewall_status", flattenFirewallStatus(output.FirewallStatus, networkInterfaceIDs)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting firewall_status: %s", err)
 	}
 	d.Set(names.AttrName, firewall.FirewallName)
 	d.Set("subnet_change_protection", firewall.SubnetChangeProtection)
-	if err := d.Set("subnet_mapping", flattenSubnetMappings(firewall.SubnetMappings)); err != nil {
+	subnetMappings := flattenSubnetMappings(firewall.SubnetMappings)
+	// Disabled subnet mappings aren't associated with the firewall and only exist in state.
+	for _, v := range d.Get("subnet_mapping").(*schema.Set).List() {
+		if tfMap, ok := v.(map[string]interface{}); ok && !tfMap[names.AttrEnabled].(bool) {
+			subnetMappings = append(subnetMappings, tfMap)
+		}
+	}
+	if err := d.Set("subnet_mapping", subnetMappings); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting subnet_mapping: %s", err)
 	}
 	d.Set("update_token", output.UpdateToken)
@@ -527,6 +599,9 @@ func expandSubnetMappings(l []interface{}) []*networkfirewall.SubnetMapping {
 		if !ok {
 			continue
 		}
+		if v, ok := tfMap[names.AttrEnabled].(bool); ok && !v {
+			continue
+		}
 		mapping := &networkfirewall.SubnetMapping{
 			SubnetId: aws.String(tfMap[names.AttrSubnetID].(string)),
 		}
@@ -546,6 +621,9 @@ func expandSubnetMappingIDs(l []interface{}) []string {
 		if !ok {
 			continue
 		}
+		if v, ok := tfMap[names.AttrEnabled].(bool); ok && !v {
+			continue
+		}
 		if id, ok := tfMap[names.AttrSubnetID].(string); ok && id != "" {
 			ids = append(ids, id)
 		}
@@ -554,19 +632,66 @@ func expandSubnetMappingIDs(l []interface{}) []string {
 	return ids
 }
 
-func flattenFirewallStatus(status *networkfirewall.FirewallStatus) []interface{} {
+// isFirewallEndpointNetworkInterfaceIDsUnavailable returns whether the firewall's endpoints couldn't be described,
+// either because they no longer exist or because the caller lacks ec2:DescribeVpcEndpoints.
+// The endpoint network interface IDs are informational, so such errors are reported as warnings.
+func isFirewallEndpointNetworkInterfaceIDsUnavailable(err error) bool {
+	return tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeUnauthorizedOperation)
+}
+
+// findFirewallEndpointNetworkInterfaceIDs returns the IDs of the network interfaces of the firewall's endpoints, keyed by endpoint ID.
+func findFirewallEndpointNetworkInterfaceIDs(ctx context.Context, conn *ec2.EC2, status *networkfirewall.FirewallStatus) (map[string]string, error) {
+	networkInterfaceIDs := make(map[string]string)
+
+	if status == nil {
+		return networkInterfaceIDs, nil
+	}
+
+	var endpointIDs []string
+
+	for _, v := range status.SyncStates {
+		if v == nil || v.Attachment == nil || v.Attachment.EndpointId == nil {
+			continue
+		}
+
+		endpointIDs = append(endpointIDs, aws.StringValue(v.Attachment.EndpointId))
+	}
+
+	if len(endpointIDs) == 0 {
+		return networkInterfaceIDs, nil
+	}
+
+	endpoints, err := tfec2.FindVPCEndpoints(ctx, conn, &ec2.DescribeVpcEndpointsInput{
+		VpcEndpointIds: aws.StringSlice(endpointIDs),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range endpoints {
+		// Firewall endpoints are Gateway Load Balancer endpoints, which have a single network interface.
+		if len(v.NetworkInterfaceIds) > 0 {
+			networkInterfaceIDs[aws.StringValue(v.VpcEndpointId)] = aws.StringValue(v.NetworkInterfaceIds[0])
+		}
+	}
+
+	return networkInterfaceIDs, nil
+}
+
+func flattenFirewallStatus(status *networkfirewall.FirewallStatus, networkInterfaceIDs map[string]string) []interface{} {
 	if status == nil {
 		return nil
 	}
 
 	m := map[string]interface{}{
-		"sync_states": flattenSyncStates(status.SyncStates),
+		"sync_states": flattenSyncStates(status.SyncStates, networkInterfaceIDs),
 	}
 
 	return []interface{}{m}
 }
 
-func flattenSyncStates(s map[string]*networkfirewall.SyncState) []interface{} {
+func flattenSyncStates(s map[string]*networkfirewall.SyncState, networkInterfaceIDs map[string]string) []interface{} {
 	if s == nil {
 		return nil
 	}
@@ -575,7 +700,7 @@ func flattenSyncStates(s map[string]*networkfirewall.SyncState) []interface{} {
 	for k, v := range s {
 		m := map[string]interface{}{
 			names.AttrAvailabilityZone: k,
-			"attachment":               flattenSyncStateAttachment(v.Attachment),
+			"attachment":               flattenSyncStateAttachment(v.Attachment, networkInterfaceIDs),
 		}
 		syncStates = append(syncStates, m)
 	}
@@ -583,14 +708,15 @@ func flattenSyncStates(s map[string]*networkfirewall.SyncState) []interface{} {
 	return syncStates
 }
 
-func flattenSyncStateAttachment(a *networkfirewall.Attachment) []interface{} {
+func flattenSyncStateAttachment(a *networkfirewall.Attachment, networkInterfaceIDs map[string]string) []interface{} {
 	if a == nil {
 		return nil
 	}
 
 	m := map[string]interface{}{
-		"endpoint_id":      aws.StringValue(a.EndpointId),
-		names.AttrSubnetID: aws.StringValue(a.SubnetId),
+		"endpoint_id":                aws.StringValue(a.EndpointId),
+		names.AttrNetworkInterfaceID: networkInterfaceIDs[aws.StringValue(a.EndpointId)],
+		names.AttrSubnetID:           aws.StringValue(a.SubnetId),
 	}
 
 	return []interface{}{m}
@@ -600,6 +726,7 @@ func flattenSubnetMappings(sm []*networkfirewall.SubnetMapping) []interface{} {
 	mappings := make([]interface{}, 0, len(sm))
 	for _, s := range sm {
 		m := map[string]interface{}{
+			names.AttrEnabled:  true,
 			names.AttrSubnetID: aws.StringValue(s.SubnetId),
 			"ip_address_type":  aws.StringValue(s.IPAddressType),
 		}
@@ -627,6 +754,9 @@ func subnetMappingsHash(v interface{}) int {
 }
 
 func subnetMappingsDiff(old, new *schema.Set) ([]string, []*networkfirewall.SubnetMapping) {
+	// Only enabled subnet mappings are associated with the firewall.
+	old, new = enabledSubnetMappings(old), enabledSubnetMappings(new)
+
 	if old.Len() == 0 {
 		return nil, expandSubnetMappings(new.List())
 	}
@@ -645,3 +775,55 @@ func subnetMappingsDiff(old, new *schema.Set) ([]string, []*networkfirewall.Subn
 
 	return subnetsToRemove, subnetsToAdd
 }
+
+func enabledSubnetMappings(s *schema.Set) *schema.Set {
+	return schema.NewSet(s.F, tfslices.Filter(s.List(), func(v interface{}) bool {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		enabled, ok := tfMap[names.AttrEnabled].(bool)
+
+		return !ok || enabled
+	}))
+}
+
+// subnetMappingsToAssociate returns the IDs of the subnets of enabled subnet mappings that aren't already associated.
+// Subnet IDs that aren't known yet are ignored.
+func subnetMappingsToAssociate(old, new *schema.Set) []string {
+	associated := make(map[string]bool)
+	for _, v := range expandSubnetMappingIDs(old.List()) {
+		associated[v] = true
+	}
+
+	var subnetIDs []string
+	for _, v := range expandSubnetMappingIDs(new.List()) {
+		if associated[v] || !strings.HasPrefix(v, "subnet-") {
+			continue
+		}
+
+		subnetIDs = append(subnetIDs, v)
+	}
+	sort.Strings(subnetIDs)
+
+	return subnetIDs
+}
+
+// checkSubnetsAvailableIPAddresses returns an error for each subnet without enough available IPv4 addresses for a firewall endpoint.
+func checkSubnetsAvailableIPAddresses(subnets []*ec2.Subnet) error {
+	var errs []error
+
+	for _, v := range subnets {
+		// IPv6-only subnets don't have IPv4 addresses.
+		if aws.BoolValue(v.Ipv6Native) {
+			continue
+		}
+
+		if count := aws.Int64Value(v.AvailableIpAddressCount); count < firewallEndpointIPAddressCount {
+			errs = append(errs, fmt.Errorf("subnet (%s) has %d available IP addresses, but a firewall endpoint requires %d; free up IP addresses in the subnet or use another subnet", aws.StringValue(v.SubnetId), count, firewallEndpointIPAddressCount))
+		}
+	}
+
+	return errors.Join(errs...)
+}
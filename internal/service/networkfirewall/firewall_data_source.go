@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -31,7 +32,7 @@ func DataSourceFirewall() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: verify.ValidARN,
-				AtLeastOneOf: []string{names.AttrARN, names.AttrName},
+				AtLeastOneOf: []string{names.AttrARN, names.AttrName, names.AttrVPCID},
 			},
 			"delete_protection": {
 				Type:     schema.TypeBool,
@@ -132,6 +133,10 @@ func DataSourceFirewall() *schema.Resource {
 													Type:     schema.TypeString,
 													Computed: true,
 												},
+												names.AttrNetworkInterfaceID: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
 												names.AttrStatus: {
 													Type:     schema.TypeString,
 													Computed: true,
@@ -153,7 +158,7 @@ func DataSourceFirewall() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				AtLeastOneOf: []string{names.AttrARN, names.AttrName},
+				AtLeastOneOf: []string{names.AttrARN, names.AttrName, names.AttrVPCID},
 				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z-]{1,128}$`), "Must have 1-128 valid characters: a-z, A-Z, 0-9 and -(hyphen)"),
 			},
 			"subnet_change_protection": {
@@ -165,9 +170,13 @@ func DataSourceFirewall() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"ip_address_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 						names.AttrSubnetID: {
 							Type:     schema.TypeString,
-							Required: true,
+							Computed: true,
 						},
 					},
 				},
@@ -178,8 +187,10 @@ func DataSourceFirewall() *schema.Resource {
 				Computed: true,
 			},
 			names.AttrVPCID: {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{names.AttrARN, names.AttrName, names.AttrVPCID},
 			},
 		},
 	}
@@ -201,8 +212,18 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 		input.FirewallName = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk(names.AttrVPCID); ok && input.FirewallArn == nil && input.FirewallName == nil {
+		firewall, err := findFirewallMetadataByVPCID(ctx, conn, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("NetworkFirewall Firewall", err))
+		}
+
+		input.FirewallArn = firewall.FirewallArn
+	}
+
 	if input.FirewallArn == nil && input.FirewallName == nil {
-		return sdkdiag.AppendErrorf(diags, "must specify either arn, name, or both")
+		return sdkdiag.AppendErrorf(diags, "must specify at least one of arn, name, or vpc_id")
 	}
 
 	output, err := conn.DescribeFirewallWithContext(ctx, input)
@@ -222,6 +243,10 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 
 	firewall := output.Firewall
 
+	if v, ok := d.GetOk(names.AttrVPCID); ok && aws.StringValue(firewall.VpcId) != v.(string) {
+		return sdkdiag.AppendErrorf(diags, "NetworkFirewall Firewall (%s) is not in VPC (%s)", aws.StringValue(firewall.FirewallArn), v.(string))
+	}
+
 	d.Set(names.AttrARN, firewall.FirewallArn)
 	d.Set("delete_protection", firewall.DeleteProtection)
 	d.Set(names.AttrDescription, firewall.Description)
@@ -229,7 +254,14 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 	d.Set(names.AttrEncryptionConfiguration, flattenDataSourceEncryptionConfiguration(firewall.EncryptionConfiguration))
 	d.Set("firewall_policy_arn", firewall.FirewallPolicyArn)
 	d.Set("firewall_policy_change_protection", firewall.FirewallPolicyChangeProtection)
-	d.Set("firewall_status", flattenDataSourceFirewallStatus(output.FirewallStatus))
+	networkInterfaceIDs, err := findFirewallEndpointNetworkInterfaceIDs(ctx, meta.(*conns.AWSClient).EC2Conn(ctx), output.FirewallStatus)
+	switch {
+	case isFirewallEndpointNetworkInterfaceIDsUnavailable(err):
+		diags = sdkdiag.AppendWarningf(diags, "reading NetworkFirewall Firewall (%s) endpoint network interfaces: %s", d.Id(), err)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall (%s) endpoint network interfaces: %s", d.Id(), err)
+	}
+	d.Set("firewall_status", flattenDataSourceFirewallStatus(output.FirewallStatus, networkInterfaceIDs))
 	d.Set("subnet_change_protection", firewall.SubnetChangeProtection)
 	d.Set("update_token", output.UpdateToken)
 	d.Set(names.AttrVPCID, firewall.VpcId)
@@ -247,7 +279,7 @@ func dataSourceFirewallResourceRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func flattenDataSourceFirewallStatus(status *networkfirewall.FirewallStatus) []interface{} {
+func flattenDataSourceFirewallStatus(status *networkfirewall.FirewallStatus, networkInterfaceIDs map[string]string) []interface{} {
 	if status == nil {
 		return nil
 	}
@@ -262,7 +294,7 @@ func flattenDataSourceFirewallStatus(status *networkfirewall.FirewallStatus) []i
 		m[names.AttrStatus] = aws.StringValue(status.Status)
 	}
 	if status.SyncStates != nil {
-		m["sync_states"] = flattenDataSourceSyncStates(status.SyncStates)
+		m["sync_states"] = flattenDataSourceSyncStates(status.SyncStates, networkInterfaceIDs)
 	}
 
 	return []interface{}{m}
@@ -310,7 +342,7 @@ func flattenDataSourceIPSetReferences(state map[string]*networkfirewall.IPSetMet
 	return ipSetReferences
 }
 
-func flattenDataSourceSyncStates(state map[string]*networkfirewall.SyncState) []interface{} {
+func flattenDataSourceSyncStates(state map[string]*networkfirewall.SyncState, networkInterfaceIDs map[string]string) []interface{} {
 	if state == nil {
 		return nil
 	}
@@ -319,7 +351,7 @@ func flattenDataSourceSyncStates(state map[string]*networkfirewall.SyncState) []
 	for k, v := range state {
 		m := map[string]interface{}{
 			names.AttrAvailabilityZone: k,
-			"attachment":               flattenDataSourceSyncStateAttachment(v.Attachment),
+			"attachment":               flattenDataSourceSyncStateAttachment(v.Attachment, networkInterfaceIDs),
 		}
 		syncStates = append(syncStates, m)
 	}
@@ -327,15 +359,16 @@ func flattenDataSourceSyncStates(state map[string]*networkfirewall.SyncState) []
 	return syncStates
 }
 
-func flattenDataSourceSyncStateAttachment(attach *networkfirewall.Attachment) []interface{} {
+func flattenDataSourceSyncStateAttachment(attach *networkfirewall.Attachment, networkInterfaceIDs map[string]string) []interface{} {
 	if attach == nil {
 		return nil
 	}
 
 	m := map[string]interface{}{
-		"endpoint_id":      aws.StringValue(attach.EndpointId),
-		names.AttrStatus:   aws.StringValue(attach.Status),
-		names.AttrSubnetID: aws.StringValue(attach.SubnetId),
+		"endpoint_id":                aws.StringValue(attach.EndpointId),
+		names.AttrNetworkInterfaceID: networkInterfaceIDs[aws.StringValue(attach.EndpointId)],
+		names.AttrStatus:             aws.StringValue(attach.Status),
+		names.AttrSubnetID:           aws.StringValue(attach.SubnetId),
 	}
 
 	return []interface{}{m}
@@ -345,6 +378,7 @@ func flattenDataSourceSubnetMappings(subnet []*networkfirewall.SubnetMapping) []
 	mappings := make([]interface{}, 0, len(subnet))
 	for _, s := range subnet {
 		m := map[string]interface{}{
+			"ip_address_type":  aws.StringValue(s.IPAddressType),
 			names.AttrSubnetID: aws.StringValue(s.SubnetId),
 		}
 		mappings = append(mappings, m)
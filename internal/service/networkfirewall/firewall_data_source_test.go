@@ -47,13 +47,17 @@ func TestAccNetworkFirewallFirewallDataSource_arn(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.0.sync_states.#", "1"),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "firewall_status.0.sync_states.*.availability_zone", subnetResourceName, names.AttrAvailabilityZone),
 					resource.TestMatchTypeSetElemNestedAttrs(dataSourceName, "firewall_status.0.sync_states.*", map[string]*regexp.Regexp{
-						"attachment.0.endpoint_id": regexache.MustCompile(`vpce-`),
+						"attachment.0.endpoint_id":          regexache.MustCompile(`vpce-`),
+						"attachment.0.network_interface_id": regexache.MustCompile(`eni-`),
 					}),
 					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.0.sync_states.0.attachment.0.status", "READY"),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "firewall_status.0.sync_states.*.attachment.0.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, vpcResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "subnet_mapping.*", map[string]string{
+						"ip_address_type": "IPV4",
+					}),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "update_token"),
@@ -102,6 +106,9 @@ func TestAccNetworkFirewallFirewallDataSource_name(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, vpcResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "subnet_mapping.*", map[string]string{
+						"ip_address_type": "IPV4",
+					}),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "update_token"),
@@ -150,6 +157,9 @@ func TestAccNetworkFirewallFirewallDataSource_arnandname(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, vpcResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "subnet_mapping.*", map[string]string{
+						"ip_address_type": "IPV4",
+					}),
 					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "update_token"),
@@ -159,6 +169,39 @@ func TestAccNetworkFirewallFirewallDataSource_arnandname(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewallDataSource_vpcID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall.test"
+	dataSourceName := "data.aws_networkfirewall_firewall.test"
+	policyResourceName := "aws_networkfirewall_firewall_policy.test"
+	subnetResourceName := "aws_subnet.test"
+	vpcResourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallDataSourceConfig_vpcID(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewall_policy_arn", policyResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, vpcResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "subnet_mapping.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "subnet_mapping.*", map[string]string{
+						"ip_address_type": "IPV4",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "subnet_mapping.*.subnet_id", subnetResourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func testAccFirewallDataSourceDependenciesConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_availability_zones" "available" {
@@ -262,3 +305,25 @@ data "aws_networkfirewall_firewall" "test" {
 }
 `, rName))
 }
+
+func testAccFirewallDataSourceConfig_vpcID(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFirewallDataSourceDependenciesConfig(rName),
+		fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test.id
+  }
+}
+
+data "aws_networkfirewall_firewall" "test" {
+  vpc_id = aws_vpc.test.id
+
+  depends_on = [aws_networkfirewall_firewall.test]
+}
+`, rName))
+}
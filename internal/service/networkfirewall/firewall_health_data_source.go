@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_networkfirewall_firewall_health")
+func DataSourceFirewallHealth() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFirewallHealthRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				AtLeastOneOf: []string{names.AttrARN, names.AttrName},
+			},
+			"configuration_sync_state_summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAvailabilityZone: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"out_of_sync_objects": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"healthy": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"lookback_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+			"metric_maximums": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+			"metric_sums": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+				AtLeastOneOf: []string{names.AttrARN, names.AttrName},
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.All(validation.IntAtLeast(60), validation.IntDivisibleBy(60)),
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFirewallHealthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.NetworkFirewallConn(ctx)
+
+	input := &networkfirewall.DescribeFirewallInput{}
+	if v, ok := d.GetOk(names.AttrARN); ok {
+		input.FirewallArn = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.FirewallName = aws.String(v.(string))
+	}
+
+	output, err := conn.DescribeFirewallWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall: %s", err)
+	}
+
+	if output == nil || output.Firewall == nil || output.FirewallStatus == nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall: empty output")
+	}
+
+	firewall, status := output.Firewall, output.FirewallStatus
+	name := aws.StringValue(firewall.FirewallName)
+	endTime := time.Now().UTC().Truncate(time.Minute)
+	startTime := endTime.Add(-time.Duration(d.Get("lookback_minutes").(int)) * time.Minute)
+
+	summaries, err := findFirewallMetricSummaries(ctx, client.CloudWatchClient(ctx), name, startTime, endTime, int32(d.Get("period").(int)))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall (%s) metrics: %s", name, err)
+	}
+
+	sums, maximums := make(map[string]interface{}, len(summaries)), make(map[string]interface{}, len(summaries))
+	for metricName, summary := range summaries {
+		sums[metricName] = summary.Sum
+		maximums[metricName] = summary.Maximum
+	}
+
+	endpoints := flattenFirewallHealthEndpoints(status.SyncStates)
+
+	d.SetId(aws.StringValue(firewall.FirewallArn))
+	d.Set(names.AttrARN, firewall.FirewallArn)
+	d.Set("configuration_sync_state_summary", status.ConfigurationSyncStateSummary)
+	d.Set("end_time", endTime.Format(time.RFC3339))
+	if err := d.Set("endpoint", endpoints); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting endpoint: %s", err)
+	}
+	d.Set("healthy", firewallIsHealthy(status))
+	d.Set("metric_maximums", maximums)
+	d.Set("metric_sums", sums)
+	d.Set(names.AttrName, name)
+	d.Set("start_time", startTime.Format(time.RFC3339))
+	d.Set(names.AttrStatus, status.Status)
+
+	return diags
+}
+
+// firewallIsHealthy returns whether the firewall and all its endpoints are ready and in sync with the firewall policy.
+func firewallIsHealthy(status *networkfirewall.FirewallStatus) bool {
+	if aws.StringValue(status.Status) != networkfirewall.FirewallStatusValueReady {
+		return false
+	}
+
+	if aws.StringValue(status.ConfigurationSyncStateSummary) != networkfirewall.ConfigurationSyncStateInSync {
+		return false
+	}
+
+	for _, v := range status.SyncStates {
+		if v == nil || v.Attachment == nil || aws.StringValue(v.Attachment.Status) != networkfirewall.AttachmentStatusReady {
+			return false
+		}
+	}
+
+	return true
+}
+
+func flattenFirewallHealthEndpoints(apiObjects map[string]*networkfirewall.SyncState) []interface{} {
+	availabilityZones := make([]string, 0, len(apiObjects))
+	for k := range apiObjects {
+		availabilityZones = append(availabilityZones, k)
+	}
+	sort.Strings(availabilityZones)
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+	for _, availabilityZone := range availabilityZones {
+		apiObject := apiObjects[availabilityZone]
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			names.AttrAvailabilityZone: availabilityZone,
+		}
+
+		if v := apiObject.Attachment; v != nil {
+			tfMap["endpoint_id"] = aws.StringValue(v.EndpointId)
+			tfMap[names.AttrStatus] = aws.StringValue(v.Status)
+			tfMap["status_message"] = aws.StringValue(v.StatusMessage)
+			tfMap[names.AttrSubnetID] = aws.StringValue(v.SubnetId)
+		}
+
+		var outOfSync []string
+		for k, v := range apiObject.Config {
+			if v == nil || aws.StringValue(v.SyncStatus) != networkfirewall.PerObjectSyncStatusInSync {
+				outOfSync = append(outOfSync, k)
+			}
+		}
+		sort.Strings(outOfSync)
+		tfMap["out_of_sync_objects"] = outOfSync
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
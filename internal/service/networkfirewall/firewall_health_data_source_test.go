@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallFirewallHealthDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkfirewall_firewall_health.test"
+	resourceName := "aws_networkfirewall_firewall.test"
+	subnetResourceName := "aws_subnet.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallHealthDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration_sync_state_summary", "IN_SYNC"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "end_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.availability_zone", subnetResourceName, names.AttrAvailabilityZone),
+					resource.TestMatchResourceAttr(dataSourceName, "endpoint.0.endpoint_id", regexache.MustCompile(`^vpce-`)),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint.0.out_of_sync_objects.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "endpoint.0.status", "READY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "endpoint.0.subnet_id", subnetResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "healthy", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "lookback_minutes", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_maximums.%", "6"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_sums.%", "6"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_sums.DroppedPackets", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "metric_sums.InvalidDroppedPackets", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(dataSourceName, "period", "300"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "start_time"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "READY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallHealthDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccFirewallDataSourceDependenciesConfig(rName),
+		fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test.id
+  }
+}
+
+data "aws_networkfirewall_firewall_health" "test" {
+  arn              = aws_networkfirewall_firewall.test.arn
+  lookback_minutes = 30
+}
+`, rName))
+}
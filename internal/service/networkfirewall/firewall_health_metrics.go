@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+const (
+	firewallMetricsNamespace         = "AWS/NetworkFirewall"
+	firewallMetricsDimensionFirewall = "FirewallName"
+)
+
+// firewallHealthMetricNames are the Network Firewall CloudWatch metrics summarized by the firewall health data source.
+var firewallHealthMetricNames = []string{
+	"DroppedPackets",
+	"InvalidDroppedPackets",
+	"OtherDroppedPackets",
+	"PassedPackets",
+	"ReceivedPackets",
+	"RejectedPackets",
+}
+
+// firewallMetricSummary summarizes the values of a metric over a lookback window.
+type firewallMetricSummary struct {
+	// Sum is the sum of all values.
+	Sum float64
+	// Maximum is the largest value in any single period, summed across dimensions (e.g. Availability Zones).
+	Maximum float64
+}
+
+// findFirewallMetricSummaries returns summaries of the specified firewall's health metrics between startTime and endTime.
+// Metrics without any datapoints are summarized as zero.
+func findFirewallMetricSummaries(ctx context.Context, conn *cloudwatch.Client, firewallName string, startTime, endTime time.Time, period int32) (map[string]firewallMetricSummary, error) {
+	summaries := make(map[string]firewallMetricSummary, len(firewallHealthMetricNames))
+	var metrics []cloudwatchtypes.Metric
+
+	for _, metricName := range firewallHealthMetricNames {
+		summaries[metricName] = firewallMetricSummary{}
+
+		input := &cloudwatch.ListMetricsInput{
+			Dimensions: []cloudwatchtypes.DimensionFilter{{
+				Name:  aws.String(firewallMetricsDimensionFirewall),
+				Value: aws.String(firewallName),
+			}},
+			MetricName: aws.String(metricName),
+			Namespace:  aws.String(firewallMetricsNamespace),
+		}
+
+		pages := cloudwatch.NewListMetricsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("listing CloudWatch Metrics (%s): %w", metricName, err)
+			}
+
+			metrics = append(metrics, page.Metrics...)
+		}
+	}
+
+	metrics = mostGranularFirewallMetrics(metrics)
+
+	if len(metrics) == 0 {
+		return summaries, nil
+	}
+
+	queries := make([]cloudwatchtypes.MetricDataQuery, 0, len(metrics))
+	metricNames := make(map[string]string, len(metrics))
+	for i := range metrics {
+		id := fmt.Sprintf("m%d", i)
+		metricNames[id] = aws.ToString(metrics[i].MetricName)
+		queries = append(queries, cloudwatchtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cloudwatchtypes.MetricStat{
+				Metric: &metrics[i],
+				Period: aws.Int32(period),
+				Stat:   aws.String(string(cloudwatchtypes.StatisticSum)),
+			},
+		})
+	}
+
+	var results []cloudwatchtypes.MetricDataResult
+	// GetMetricData accepts at most 500 queries per request.
+	const (
+		maxQueries = 500
+	)
+	for len(queries) > 0 {
+		n := min(len(queries), maxQueries)
+		input := &cloudwatch.GetMetricDataInput{
+			EndTime:           aws.Time(endTime),
+			MetricDataQueries: queries[:n],
+			StartTime:         aws.Time(startTime),
+		}
+		queries = queries[n:]
+
+		pages := cloudwatch.NewGetMetricDataPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("reading CloudWatch Metric data: %w", err)
+			}
+
+			results = append(results, page.MetricDataResults...)
+		}
+	}
+
+	for metricName, summary := range summarizeFirewallMetricData(results, metricNames) {
+		summaries[metricName] = summary
+	}
+
+	return summaries, nil
+}
+
+// mostGranularFirewallMetrics returns, for each metric name, only the metrics with the largest number of dimensions.
+// Network Firewall may publish the same metric with several dimension combinations (e.g. with and without `Engine`).
+// The most granular combinations partition the traffic, so summing them doesn't count any packet twice.
+func mostGranularFirewallMetrics(metrics []cloudwatchtypes.Metric) []cloudwatchtypes.Metric {
+	maxDimensions := make(map[string]int)
+	for _, v := range metrics {
+		metricName := aws.ToString(v.MetricName)
+		maxDimensions[metricName] = max(maxDimensions[metricName], len(v.Dimensions))
+	}
+
+	var result []cloudwatchtypes.Metric
+	for _, v := range metrics {
+		if len(v.Dimensions) == maxDimensions[aws.ToString(v.MetricName)] {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+// summarizeFirewallMetricData summarizes metric data results, whose query IDs are mapped to metric names.
+// Values for the same metric name and timestamp are summed before the maximum is determined.
+func summarizeFirewallMetricData(results []cloudwatchtypes.MetricDataResult, metricNames map[string]string) map[string]firewallMetricSummary {
+	periodSums := make(map[string]map[time.Time]float64)
+
+	for _, result := range results {
+		metricName, ok := metricNames[aws.ToString(result.Id)]
+		if !ok {
+			continue
+		}
+
+		if _, ok := periodSums[metricName]; !ok {
+			periodSums[metricName] = make(map[time.Time]float64)
+		}
+
+		for i, timestamp := range result.Timestamps {
+			if i < len(result.Values) {
+				periodSums[metricName][timestamp] += result.Values[i]
+			}
+		}
+	}
+
+	summaries := make(map[string]firewallMetricSummary, len(periodSums))
+	for metricName, sums := range periodSums {
+		var summary firewallMetricSummary
+
+		for _, v := range sums {
+			summary.Sum += v
+			summary.Maximum = max(summary.Maximum, v)
+		}
+
+		summaries[metricName] = summary
+	}
+
+	return summaries
+}
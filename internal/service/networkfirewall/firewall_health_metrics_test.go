@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMostGranularFirewallMetrics(t *testing.T) {
+	t.Parallel()
+
+	metric := func(metricName string, dimensions ...string) cloudwatchtypes.Metric {
+		apiObject := cloudwatchtypes.Metric{
+			MetricName: aws.String(metricName),
+			Namespace:  aws.String(firewallMetricsNamespace),
+		}
+		for _, v := range dimensions {
+			apiObject.Dimensions = append(apiObject.Dimensions, cloudwatchtypes.Dimension{Name: aws.String(v)})
+		}
+		return apiObject
+	}
+
+	metrics := []cloudwatchtypes.Metric{
+		metric("DroppedPackets", "FirewallName", "AvailabilityZone", "Engine"),
+		metric("DroppedPackets", "FirewallName", "AvailabilityZone"),
+		metric("DroppedPackets", "FirewallName", "AvailabilityZone", "Engine"),
+		metric("ReceivedPackets", "FirewallName", "AvailabilityZone"),
+		metric("ReceivedPackets", "FirewallName", "AvailabilityZone"),
+	}
+
+	var got []string
+	for _, v := range mostGranularFirewallMetrics(metrics) {
+		got = append(got, aws.ToString(v.MetricName))
+	}
+	want := []string{"DroppedPackets", "DroppedPackets", "ReceivedPackets", "ReceivedPackets"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSummarizeFirewallMetricData(t *testing.T) {
+	t.Parallel()
+
+	t0 := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	t1 := t0.Add(5 * time.Minute)
+	results := []cloudwatchtypes.MetricDataResult{
+		{
+			Id:         aws.String("m0"),
+			Timestamps: []time.Time{t1, t0},
+			Values:     []float64{10, 2},
+		},
+		{
+			Id:         aws.String("m1"),
+			Timestamps: []time.Time{t1, t0},
+			Values:     []float64{5, 20},
+		},
+		{
+			Id:         aws.String("m2"),
+			Timestamps: []time.Time{t0},
+			Values:     []float64{100},
+		},
+		{
+			Id:         aws.String("m3"),
+			Timestamps: []time.Time{},
+			Values:     []float64{},
+		},
+		{
+			Id:         aws.String("unknown"),
+			Timestamps: []time.Time{t0},
+			Values:     []float64{1000},
+		},
+	}
+	metricNames := map[string]string{
+		"m0": "DroppedPackets",
+		"m1": "DroppedPackets",
+		"m2": "ReceivedPackets",
+		"m3": "InvalidDroppedPackets",
+	}
+
+	got := summarizeFirewallMetricData(results, metricNames)
+	want := map[string]firewallMetricSummary{
+		"DroppedPackets":        {Sum: 37, Maximum: 22},
+		"InvalidDroppedPackets": {},
+		"ReceivedPackets":       {Sum: 100, Maximum: 100},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Firewall Manager names the firewalls that it creates for a Network Firewall policy
+// FMManagedNetworkFirewall<policy name><policy ID><VPC ID>.
+const firewallManagerFirewallNamePrefix = "FMManagedNetworkFirewall"
+
+// @SDKDataSource("aws_networkfirewall_firewall_manager_policy_scope")
+func DataSourceFirewallManagerPolicyScope() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFirewallManagerPolicyScopeRead,
+
+		Schema: map[string]*schema.Schema{
+			"firewalls": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"firewall_policy_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"managed_firewall_present": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			names.AttrVPCID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceFirewallManagerPolicyScopeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	input := &networkfirewall.ListFirewallsInput{}
+
+	if v, ok := d.GetOk(names.AttrVPCID); ok {
+		input.VpcIds = aws.StringSlice([]string{v.(string)})
+	}
+
+	policyID := d.Get("policy_id").(string)
+	output, err := findFirewallMetadatas(ctx, conn, input, func(v *networkfirewall.FirewallMetadata) bool {
+		name := aws.StringValue(v.FirewallName)
+
+		return strings.HasPrefix(name, firewallManagerFirewallNamePrefix) && strings.Contains(name, policyID)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewalls: %s", err)
+	}
+
+	var tfList []interface{}
+
+	for _, v := range output {
+		arn := aws.StringValue(v.FirewallArn)
+		firewall, err := FindFirewallByARN(ctx, conn, arn)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall (%s): %s", arn, err)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrARN:         arn,
+			"firewall_policy_arn": aws.StringValue(firewall.Firewall.FirewallPolicyArn),
+			names.AttrName:        aws.StringValue(firewall.Firewall.FirewallName),
+			names.AttrVPCID:       aws.StringValue(firewall.Firewall.VpcId),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("firewalls", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting firewalls: %s", err)
+	}
+	d.Set("managed_firewall_present", len(tfList) > 0)
+
+	return diags
+}
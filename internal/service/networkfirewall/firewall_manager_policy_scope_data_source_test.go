@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallFirewallManagerPolicyScopeDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyID, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPolicyID, err := uuid.GenerateUUID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	managedResourceName := "aws_networkfirewall_firewall.managed"
+	dataSourceName := "data.aws_networkfirewall_firewall_manager_policy_scope.test"
+	otherDataSourceName := "data.aws_networkfirewall_firewall_manager_policy_scope.other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallManagerPolicyScopeDataSourceConfig_basic(rName, policyID, otherPolicyID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "managed_firewall_present", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "firewalls.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewalls.0.arn", managedResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewalls.0.firewall_policy_arn", managedResourceName, "firewall_policy_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewalls.0.name", managedResourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "firewalls.0.vpc_id", managedResourceName, names.AttrVPCID),
+					resource.TestCheckResourceAttr(otherDataSourceName, "managed_firewall_present", "false"),
+					resource.TestCheckResourceAttr(otherDataSourceName, "firewalls.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallManagerPolicyScopeDataSourceConfig_basic(rName, policyID, otherPolicyID string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_basic(rName), fmt.Sprintf(`
+# Named as Firewall Manager names the firewalls it creates.
+resource "aws_networkfirewall_firewall" "managed" {
+  name                = "FMManagedNetworkFirewalltest%[1]s${aws_vpc.test.id}"
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test[0].id
+  }
+}
+
+data "aws_networkfirewall_firewall_manager_policy_scope" "test" {
+  policy_id = %[1]q
+  vpc_id    = aws_vpc.test.id
+
+  depends_on = [aws_networkfirewall_firewall.test, aws_networkfirewall_firewall.managed]
+}
+
+data "aws_networkfirewall_firewall_manager_policy_scope" "other" {
+  policy_id = %[2]q
+  vpc_id    = aws_vpc.test.id
+
+  depends_on = [aws_networkfirewall_firewall.test, aws_networkfirewall_firewall.managed]
+}
+`, policyID, otherPolicyID))
+}
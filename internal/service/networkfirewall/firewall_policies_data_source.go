@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_networkfirewall_firewall_policies")
+func DataSourceFirewallPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFirewallPoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceFirewallPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	policies, err := findFirewallPolicies(ctx, conn, &networkfirewall.ListFirewallPoliciesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall Policies: %s", err)
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	var arns, resourceNames []string
+
+	for _, v := range policies {
+		arn := aws.StringValue(v.Arn)
+
+		if len(tagsToMatch) > 0 {
+			tags, err := listTags(ctx, conn, arn)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for NetworkFirewall Firewall Policy (%s): %s", arn, err)
+			}
+
+			if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+		resourceNames = append(resourceNames, aws.StringValue(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set("names", resourceNames)
+
+	return diags
+}
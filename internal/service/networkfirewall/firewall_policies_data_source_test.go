@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallFirewallPoliciesDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test1"
+	dataSourceName1 := "data.aws_networkfirewall_firewall_policies.all"
+	dataSourceName2 := "data.aws_networkfirewall_firewall_policies.tags"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPoliciesDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanOrEqualValue(dataSourceName1, "arns.#", 2),
+					resource.TestCheckResourceAttr(dataSourceName2, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName2, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName2, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName2, "names.0", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallPoliciesDataSourceConfig_tags(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test1" {
+  name = "%[1]s-1"
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test2" {
+  name = "%[1]s-2"
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+  }
+}
+
+data "aws_networkfirewall_firewall_policies" "all" {
+  depends_on = [aws_networkfirewall_firewall_policy.test1, aws_networkfirewall_firewall_policy.test2]
+}
+
+data "aws_networkfirewall_firewall_policies" "tags" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkfirewall_firewall_policy.test1, aws_networkfirewall_firewall_policy.test2]
+}
+`, rName)
+}
@@ -5,7 +5,9 @@ package networkfirewall
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -20,12 +22,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Maximum capacity of the stateless and stateful rule groups referenced by a firewall policy.
+	firewallPolicyStatelessCapacityMax = 10000
+	firewallPolicyStatefulCapacityMax  = 30000
+)
+
 // @SDKResource("aws_networkfirewall_firewall_policy", name="Firewall Policy")
 // @Tags(identifierAttribute="id")
 func ResourceFirewallPolicy() *schema.Resource {
@@ -36,7 +45,15 @@ func ResourceFirewallPolicy() *schema.Resource {
 		DeleteWithoutTimeout: resourceFirewallPolicyDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_for_firewall_sync", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -188,6 +205,11 @@ func ResourceFirewallPolicy() *schema.Resource {
 					},
 				},
 			},
+			"min_capacity_headroom": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -199,6 +221,11 @@ func ResourceFirewallPolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"wait_for_firewall_sync": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: customdiff.Sequence(
@@ -207,6 +234,8 @@ func ResourceFirewallPolicy() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("firewall_policy.0.stateful_engine_options.0.rule_order", d)
 			},
+			customizeDiffFirewallPolicyCapacityHeadroom,
+			customizeDiffFirewallPolicyCustomActionNames,
 			verify.SetTagsDiff,
 		),
 	}
@@ -292,11 +321,17 @@ func resourceFirewallPolicyUpdate(ctx context.Context, d *schema.ResourceData, m
 			input.Description = aws.String(v.(string))
 		}
 
-		_, err := conn.UpdateFirewallPolicyWithContext(ctx, input)
+		output, err := conn.UpdateFirewallPolicyWithContext(ctx, input)
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
 		}
+
+		if d.Get("wait_for_firewall_sync").(bool) {
+			if err := waitFirewallPolicyFirewallsSynced(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id(), aws.StringValue(output.UpdateToken)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall Policy (%s): %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceFirewallPolicyRead(ctx, d, meta)...)
@@ -332,6 +367,81 @@ func resourceFirewallPolicyDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// customizeDiffFirewallPolicyCapacityHeadroom validates that the capacity of the referenced stateless and stateful rule groups
+// leaves at least min_capacity_headroom units below the maximum capacity of a firewall policy.
+func customizeDiffFirewallPolicyCapacityHeadroom(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	headroom, ok := d.GetOk("min_capacity_headroom")
+	if !ok {
+		return nil
+	}
+
+	tfList, ok := d.Get("firewall_policy").([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	for _, v := range []struct {
+		key         string
+		maxCapacity int
+	}{
+		{"stateless_rule_group_reference", firewallPolicyStatelessCapacityMax},
+		{"stateful_rule_group_reference", firewallPolicyStatefulCapacityMax},
+	} {
+		tfSet, ok := tfMap[v.key].(*schema.Set)
+		if !ok || tfSet.Len() == 0 {
+			continue
+		}
+
+		var capacity int
+
+		for _, tfMapRaw := range tfSet.List() {
+			arn := tfMapRaw.(map[string]interface{})[names.AttrResourceARN].(string)
+
+			// The ARN of a rule group that hasn't been created yet is unknown.
+			if arn == "" {
+				log.Printf("[DEBUG] Skipping NetworkFirewall Firewall Policy %s capacity headroom validation: rule group ARN is unknown", v.key)
+				capacity = -1
+				break
+			}
+
+			output, err := FindRuleGroupByARN(ctx, conn, arn)
+
+			if err != nil {
+				return fmt.Errorf("reading NetworkFirewall Rule Group (%s): %w", arn, err)
+			}
+
+			capacity += int(aws.Int64Value(output.RuleGroupResponse.Capacity))
+		}
+
+		if capacity < 0 {
+			continue
+		}
+
+		if n := v.maxCapacity - capacity; n < headroom.(int) {
+			return fmt.Errorf("firewall_policy.0.%s: rule groups use %d of the maximum capacity of %d, leaving %d units, which is less than min_capacity_headroom (%d)", v.key, capacity, v.maxCapacity, n, headroom.(int))
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffFirewallPolicyCustomActionNames fails the plan if several stateless custom actions share a name.
+func customizeDiffFirewallPolicyCustomActionNames(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tfSet, ok := d.Get("firewall_policy.0.stateless_custom_action").(*schema.Set)
+	if !ok || tfSet.Len() == 0 {
+		return nil
+	}
+
+	if v := duplicateCustomActionNames(tfSet.List()); len(v) > 0 {
+		return fmt.Errorf("stateless_custom_action action_name must be unique within a firewall policy, duplicated: %s", strings.Join(v, ", "))
+	}
+
+	return nil
+}
+
 func FindFirewallPolicyByARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) (*networkfirewall.DescribeFirewallPolicyOutput, error) {
 	input := &networkfirewall.DescribeFirewallPolicyInput{
 		FirewallPolicyArn: aws.String(arn),
@@ -390,6 +500,115 @@ func waitFirewallPolicyDeleted(ctx context.Context, conn *networkfirewall.Networ
 	return nil, err
 }
 
+// findFirewallARNsByFirewallPolicyARN returns the ARNs of the firewalls that use the specified firewall policy.
+func findFirewallARNsByFirewallPolicyARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, policyARN string) ([]string, error) {
+	firewalls, err := findFirewallMetadatas(ctx, conn, &networkfirewall.ListFirewallsInput{}, tfslices.PredicateTrue[*networkfirewall.FirewallMetadata]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	var arns []string
+
+	for _, v := range firewalls {
+		arn := aws.StringValue(v.FirewallArn)
+		output, err := FindFirewallByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("reading NetworkFirewall Firewall (%s): %w", arn, err)
+		}
+
+		if aws.StringValue(output.Firewall.FirewallPolicyArn) == policyARN {
+			arns = append(arns, arn)
+		}
+	}
+
+	return arns, nil
+}
+
+// firewallPolicySyncState returns the state of the synchronization of the specified version of a firewall policy to a firewall.
+// The policy is pending in an Availability Zone until the firewall reports the version's update token there.
+func firewallPolicySyncState(status *networkfirewall.FirewallStatus, policyARN, updateToken string) string {
+	if len(status.SyncStates) == 0 {
+		return aws.StringValue(status.ConfigurationSyncStateSummary)
+	}
+
+	for _, v := range status.SyncStates {
+		if v == nil {
+			continue
+		}
+
+		v, ok := v.Config[policyARN]
+
+		if !ok || v == nil || aws.StringValue(v.UpdateToken) != updateToken {
+			return networkfirewall.PerObjectSyncStatusPending
+		}
+
+		if state := aws.StringValue(v.SyncStatus); state != networkfirewall.PerObjectSyncStatusInSync {
+			return state
+		}
+	}
+
+	return networkfirewall.PerObjectSyncStatusInSync
+}
+
+func statusFirewallPolicyFirewallSync(ctx context.Context, conn *networkfirewall.NetworkFirewall, firewallARN, policyARN, updateToken string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFirewallByARN(ctx, conn, firewallARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, firewallPolicySyncState(output.FirewallStatus, policyARN, updateToken), nil
+	}
+}
+
+func waitFirewallPolicyFirewallSynced(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, firewallARN, policyARN, updateToken string) (*networkfirewall.DescribeFirewallOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{networkfirewall.ConfigurationSyncStatePending},
+		Target:  []string{networkfirewall.ConfigurationSyncStateInSync},
+		Refresh: statusFirewallPolicyFirewallSync(ctx, conn, firewallARN, policyARN, updateToken),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkfirewall.DescribeFirewallOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitFirewallPolicyFirewallsSynced waits for all firewalls that use the specified firewall policy to synchronize the version identified by the update token.
+// The timeout applies to the firewalls as a whole.
+func waitFirewallPolicyFirewallsSynced(ctx context.Context, conn *networkfirewall.NetworkFirewall, timeout time.Duration, policyARN, updateToken string) error {
+	deadline := time.Now().Add(timeout)
+
+	arns, err := findFirewallARNsByFirewallPolicyARN(ctx, conn, policyARN)
+
+	if err != nil {
+		return fmt.Errorf("listing NetworkFirewall Firewalls: %w", err)
+	}
+
+	for _, arn := range arns {
+		if _, err := waitFirewallPolicyFirewallSynced(ctx, conn, time.Until(deadline), arn, policyARN, updateToken); err != nil {
+			return fmt.Errorf("waiting for NetworkFirewall Firewall (%s) configuration sync: %w", arn, err)
+		}
+	}
+
+	return nil
+}
+
 func expandPolicyVariables(tfMap map[string]interface{}) *networkfirewall.PolicyVariables {
 	if tfMap == nil {
 		return nil
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_networkfirewall_firewall_policy_analysis")
+func DataSourceFirewallPolicyAnalysis() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFirewallPolicyAnalysisRead,
+
+		Schema: map[string]*schema.Schema{
+			"fail_on_findings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"finding": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analysis_detail": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"identified_rule_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"identified_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"firewall_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceFirewallPolicyAnalysisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	policyARN := d.Get("firewall_policy_arn").(string)
+	output, err := FindFirewallPolicyByARN(ctx, conn, policyARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall Policy (%s): %s", policyARN, err)
+	}
+
+	policy := output.FirewallPolicy
+	if policy == nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall Policy (%s): empty output.FirewallPolicy", policyARN)
+	}
+
+	// Network Firewall only analyzes stateless rule groups.
+	var findings []interface{}
+	var summaries []string
+	for _, v := range policy.StatelessRuleGroupReferences {
+		ruleGroupARN := aws.StringValue(v.ResourceArn)
+
+		if isManagedRuleGroupARN(ruleGroupARN) {
+			continue
+		}
+
+		results, err := findRuleGroupAnalysisResultsByARN(ctx, conn, ruleGroupARN)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "analyzing NetworkFirewall Rule Group (%s): %s", ruleGroupARN, err)
+		}
+
+		for _, v := range results {
+			findings = append(findings, map[string]interface{}{
+				"analysis_detail":     aws.StringValue(v.AnalysisDetail),
+				"identified_rule_ids": aws.StringValueSlice(v.IdentifiedRuleIds),
+				"identified_type":     aws.StringValue(v.IdentifiedType),
+				"rule_group_arn":      ruleGroupARN,
+			})
+			summaries = append(summaries, fmt.Sprintf("%s: %s (rules %s)", ruleGroupARN, aws.StringValue(v.IdentifiedType), strings.Join(aws.StringValueSlice(v.IdentifiedRuleIds), ", ")))
+		}
+	}
+
+	if d.Get("fail_on_findings").(bool) && len(summaries) > 0 {
+		return sdkdiag.AppendErrorf(diags, "NetworkFirewall Firewall Policy (%s) has %d analysis findings:\n%s", policyARN, len(summaries), strings.Join(summaries, "\n"))
+	}
+
+	d.SetId(aws.StringValue(output.FirewallPolicyResponse.FirewallPolicyArn))
+
+	if err := d.Set("finding", findings); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting finding: %s", err)
+	}
+
+	return diags
+}
+
+// findRuleGroupAnalysisResultsByARN returns the results of the analysis of a stateless rule group.
+// The StartAnalysisReport and GetAnalysisReportResults operations are not available in the AWS SDK for Go v1.53.0.
+func findRuleGroupAnalysisResultsByARN(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string) ([]*networkfirewall.AnalysisResult, error) {
+	input := &networkfirewall.DescribeRuleGroupInput{
+		AnalyzeRuleGroup: aws.Bool(true),
+		RuleGroupArn:     aws.String(arn),
+	}
+
+	output, err := conn.DescribeRuleGroupWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RuleGroupResponse == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RuleGroupResponse.AnalysisResults, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallFirewallPolicyAnalysisDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkfirewall_firewall_policy_analysis.test"
+	ruleGroupResourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyAnalysisDataSourceConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, "aws_networkfirewall_firewall_policy.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "finding.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "finding.0.analysis_detail"),
+					resource.TestCheckResourceAttr(dataSourceName, "finding.0.identified_rule_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "finding.0.identified_rule_ids.0", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "finding.0.identified_type", "STATELESS_RULE_FORWARDING_ASYMMETRICALLY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "finding.0.rule_group_arn", ruleGroupResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config:      testAccFirewallPolicyAnalysisDataSourceConfig_basic(rName, true),
+				ExpectError: regexache.MustCompile(`has 1 analysis findings`),
+			},
+		},
+	})
+}
+
+func testAccFirewallPolicyAnalysisDataSourceConfig_basic(rName string, failOnFindings bool) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:pass"]
+
+            match_attributes {
+              protocols = [6]
+
+              source {
+                address_definition = "10.1.0.0/24"
+              }
+
+              destination {
+                address_definition = "20.1.0.0/24"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_default_actions          = ["aws:drop"]
+    stateless_fragment_default_actions = ["aws:drop"]
+
+    stateless_rule_group_reference {
+      priority     = 1
+      resource_arn = aws_networkfirewall_rule_group.test.arn
+    }
+  }
+}
+
+data "aws_networkfirewall_firewall_policy_analysis" "test" {
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  fail_on_findings    = %[2]t
+}
+`, rName, failOnFindings)
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_networkfirewall_firewall_policy_evaluation")
+func DataSourceFirewallPolicyEvaluation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFirewallPolicyEvaluationRead,
+
+		Schema: map[string]*schema.Schema{
+			"firewall_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"flow": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"destination_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumberOrZero,
+						},
+						"http_host": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrProtocol: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(flowProtocol_Values(), false),
+						},
+						names.AttrSource: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsIPAddress,
+						},
+						"source_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IsPortNumberOrZero,
+						},
+						"tls_sni": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"result": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"engine": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"matched_rule": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rule_group_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"unevaluated_rule_group_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceFirewallPolicyEvaluationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+	policyARN := d.Get("firewall_policy_arn").(string)
+	output, err := FindFirewallPolicyByARN(ctx, conn, policyARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall Policy (%s): %s", policyARN, err)
+	}
+
+	policy := output.FirewallPolicy
+	if policy == nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Firewall Policy (%s): empty output.FirewallPolicy", policyARN)
+	}
+
+	var ruleGroupARNs []string
+	for _, v := range policy.StatelessRuleGroupReferences {
+		ruleGroupARNs = append(ruleGroupARNs, aws.StringValue(v.ResourceArn))
+	}
+	for _, v := range policy.StatefulRuleGroupReferences {
+		ruleGroupARNs = append(ruleGroupARNs, aws.StringValue(v.ResourceArn))
+	}
+
+	ruleGroups := make(map[string]*networkfirewall.RuleGroup)
+	for _, ruleGroupARN := range ruleGroupARNs {
+		// The rules of AWS managed rule groups aren't visible and can't be evaluated.
+		if isManagedRuleGroupARN(ruleGroupARN) {
+			continue
+		}
+
+		output, err := FindRuleGroupByARN(ctx, conn, ruleGroupARN)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Rule Group (%s): %s", ruleGroupARN, err)
+		}
+
+		ruleGroups[ruleGroupARN] = output.RuleGroup
+	}
+
+	evaluator := newPolicyEvaluator(policy, ruleGroups)
+
+	var results []interface{}
+	for i, v := range d.Get("flow").([]interface{}) {
+		flow, err := expandFlowSample(v.(map[string]interface{}))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "flow.%d: %s", i, err)
+		}
+
+		results = append(results, flattenFlowVerdict(evaluator.evaluate(flow)))
+	}
+
+	d.SetId(aws.StringValue(output.FirewallPolicyResponse.FirewallPolicyArn))
+
+	if err := d.Set("result", results); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting result: %s", err)
+	}
+	d.Set("unevaluated_rule_group_arns", evaluator.unevaluatedRuleGroups())
+
+	return diags
+}
+
+func isManagedRuleGroupARN(v string) bool {
+	parsedARN, err := arn.Parse(v)
+
+	return err == nil && parsedARN.AccountID == "aws-managed"
+}
+
+func expandFlowSample(tfMap map[string]interface{}) (*flowSample, error) {
+	source, err := netip.ParseAddr(tfMap[names.AttrSource].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := netip.ParseAddr(tfMap[names.AttrDestination].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &flowSample{
+		protocol:        tfMap[names.AttrProtocol].(string),
+		source:          source.Unmap(),
+		sourcePort:      tfMap["source_port"].(int),
+		destination:     destination.Unmap(),
+		destinationPort: tfMap["destination_port"].(int),
+		httpHost:        strings.TrimSpace(tfMap["http_host"].(string)),
+		tlsSNI:          strings.TrimSpace(tfMap["tls_sni"].(string)),
+	}, nil
+}
+
+func flattenFlowVerdict(apiObject *flowVerdict) map[string]interface{} {
+	return map[string]interface{}{
+		names.AttrAction: apiObject.action,
+		"alert":          apiObject.alert,
+		"engine":         apiObject.engine,
+		"matched_rule":   apiObject.matchedRule,
+		"rule_group_arn": apiObject.ruleGroupARN,
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallFirewallPolicyEvaluationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_networkfirewall_firewall_policy_evaluation.test"
+	ruleGroupResourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyEvaluationDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, "aws_networkfirewall_firewall_policy.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "result.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.action", "PASS"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.alert", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.engine", "STATEFUL"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.0.matched_rule", "sid:1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.0.rule_group_arn", ruleGroupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.action", "DROP"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.alert", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.engine", "STATEFUL"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.1.matched_rule", "sid:2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "result.1.rule_group_arn", ruleGroupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "result.2.action", "DROP"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.2.engine", "STATEFUL"),
+					resource.TestCheckResourceAttr(dataSourceName, "result.2.matched_rule", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "result.2.rule_group_arn", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "unevaluated_rule_group_arns.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallPolicyEvaluationDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rule_variables {
+      ip_sets {
+        key = "HOME_NET"
+
+        ip_set {
+          definition = ["10.0.0.0/16"]
+        }
+      }
+    }
+
+    rules_source {
+      rules_string = <<EOF
+pass tls $HOME_NET any -> any 443 (tls.sni; dotprefix; content:".example.com"; nocase; endswith; sid:1;)
+drop tcp any any -> $HOME_NET 22 (msg:"ssh"; sid:2;)
+EOF
+    }
+
+    stateful_rule_options {
+      rule_order = "STRICT_ORDER"
+    }
+  }
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name = %[1]q
+
+  firewall_policy {
+    stateless_default_actions          = ["aws:forward_to_sfe"]
+    stateless_fragment_default_actions = ["aws:forward_to_sfe"]
+    stateful_default_actions           = ["aws:drop_established"]
+
+    stateful_engine_options {
+      rule_order = "STRICT_ORDER"
+    }
+
+    stateful_rule_group_reference {
+      priority     = 1
+      resource_arn = aws_networkfirewall_rule_group.test.arn
+    }
+  }
+}
+
+data "aws_networkfirewall_firewall_policy_evaluation" "test" {
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+
+  flow {
+    protocol         = "TCP"
+    source           = "10.0.1.10"
+    destination      = "192.0.2.10"
+    destination_port = 443
+    tls_sni          = "www.example.com"
+  }
+
+  flow {
+    protocol         = "TCP"
+    source           = "198.51.100.10"
+    destination      = "10.0.1.10"
+    destination_port = 22
+  }
+
+  flow {
+    protocol         = "UDP"
+    source           = "10.0.1.10"
+    destination      = "192.0.2.53"
+    destination_port = 53
+  }
+}
+`, rName)
+}
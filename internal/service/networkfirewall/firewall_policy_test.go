@@ -6,8 +6,10 @@ package networkfirewall_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -177,6 +179,43 @@ func TestAccNetworkFirewallFirewallPolicy_policyVariables(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicy_waitForFirewallSync(t *testing.T) {
+	ctx := acctest.Context(t)
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyConfig_waitForFirewallSync(rName, "aws:drop"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_firewall_sync", "true"),
+				),
+			},
+			{
+				Config: testAccFirewallPolicyConfig_waitForFirewallSync(rName, "aws:pass"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckTypeSetElemAttr(resourceName, "firewall_policy.0.stateless_fragment_default_actions.*", "aws:pass"),
+					resource.TestCheckResourceAttr("data.aws_networkfirewall_firewall.test", "firewall_status.0.configuration_sync_state_summary", "IN_SYNC"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_firewall_sync"},
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewallPolicy_statefulDefaultActions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
@@ -623,6 +662,46 @@ func TestAccNetworkFirewallFirewallPolicy_statelessRuleGroupReference(t *testing
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicy_minCapacityHeadroom(t *testing.T) {
+	ctx := acctest.Context(t)
+	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyConfig_minCapacityHeadroom(rName, 9000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity_headroom", "9000"),
+				),
+			},
+			{
+				Config:      testAccFirewallPolicyConfig_minCapacityHeadroom(rName, 9950),
+				ExpectError: regexache.MustCompile(`rule groups use 100 of the maximum capacity of 10000, leaving 9900 units, which is less than min_capacity_headroom \(9950\)`),
+			},
+			{
+				Config: testAccFirewallPolicyConfig_minCapacityHeadroom(rName, 9900),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resourceName, &firewallPolicy),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity_headroom", "9900"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"min_capacity_headroom"},
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewallPolicy_updateStatelessRuleGroupReference(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy networkfirewall.DescribeFirewallPolicyOutput
@@ -756,6 +835,58 @@ func TestAccNetworkFirewallFirewallPolicy_statelessCustomAction(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewallPolicy_StatelessCustomAction_shared(t *testing.T) {
+	ctx := acctest.Context(t)
+	var firewallPolicy1, firewallPolicy2 networkfirewall.DescribeFirewallPolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_networkfirewall_firewall_policy.test.0"
+	resource2Name := "aws_networkfirewall_firewall_policy.test.1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallPolicyConfig_statelessCustomActionShared(rName, "CustomAction2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallPolicyExists(ctx, resource1Name, &firewallPolicy1),
+					testAccCheckFirewallPolicyExists(ctx, resource2Name, &firewallPolicy2),
+					resource.TestCheckResourceAttr(resource1Name, "firewall_policy.0.stateless_custom_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resource1Name, "firewall_policy.0.stateless_custom_action.*", map[string]string{
+						"action_name": "CustomAction2",
+						"action_definition.0.publish_metric_action.0.dimension.#": "1",
+					}),
+					resource.TestCheckResourceAttr(resource2Name, "firewall_policy.0.stateless_custom_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resource2Name, "firewall_policy.0.stateless_custom_action.*", map[string]string{
+						"action_name": "CustomAction2",
+						"action_definition.0.publish_metric_action.0.dimension.#": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallFirewallPolicy_StatelessCustomAction_duplicateName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFirewallPolicyConfig_statelessCustomActionShared(rName, "CustomAction1"),
+				ExpectError: regexache.MustCompile(`stateless_custom_action action_name must be unique within a firewall policy, duplicated: CustomAction1`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewallPolicy_updateStatelessCustomAction(t *testing.T) {
 	ctx := acctest.Context(t)
 	var firewallPolicy1, firewallPolicy2, firewallPolicy3, firewallPolicy4 networkfirewall.DescribeFirewallPolicyOutput
@@ -1032,6 +1163,139 @@ func TestAccNetworkFirewallFirewallPolicy_disappears(t *testing.T) {
 	})
 }
 
+func TestDuplicateCustomActionNames(t *testing.T) {
+	t.Parallel()
+
+	action := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			"action_name": name,
+		}
+	}
+
+	testCases := map[string]struct {
+		tfList []interface{}
+		want   []string
+	}{
+		"empty": {
+			tfList: nil,
+			want:   nil,
+		},
+		"unique": {
+			tfList: []interface{}{action("Action1"), action("Action2")},
+			want:   nil,
+		},
+		"duplicate": {
+			tfList: []interface{}{action("Action2"), action("Action1"), action("Action2"), action("Action1"), action("Action2")},
+			want:   []string{"Action1", "Action2"},
+		},
+		"unknown": {
+			tfList: []interface{}{action(""), action("")},
+			want:   nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfnetworkfirewall.DuplicateCustomActionNames(testCase.tfList), testCase.want; !reflect.DeepEqual(got, want) {
+				t.Errorf("DuplicateCustomActionNames() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestFirewallPolicySyncState(t *testing.T) {
+	t.Parallel()
+
+	const (
+		policyARN   = "arn:aws:network-firewall:us-west-2:123456789012:firewall-policy/test" //lintignore:AWSAT003,AWSAT005
+		updateToken = "new"
+	)
+
+	syncState := func(syncStatus, updateToken string) *networkfirewall.SyncState {
+		return &networkfirewall.SyncState{
+			Config: map[string]*networkfirewall.PerObjectStatus{
+				policyARN: {SyncStatus: aws.String(syncStatus), UpdateToken: aws.String(updateToken)},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		status *networkfirewall.FirewallStatus
+		want   string
+	}{
+		"in sync": {
+			status: &networkfirewall.FirewallStatus{
+				ConfigurationSyncStateSummary: aws.String(networkfirewall.ConfigurationSyncStateInSync),
+				SyncStates: map[string]*networkfirewall.SyncState{
+					"us-west-2a": syncState(networkfirewall.PerObjectSyncStatusInSync, updateToken), //lintignore:AWSAT003
+					"us-west-2b": syncState(networkfirewall.PerObjectSyncStatusInSync, updateToken), //lintignore:AWSAT003
+				},
+			},
+			want: networkfirewall.ConfigurationSyncStateInSync,
+		},
+		"policy pending": {
+			status: &networkfirewall.FirewallStatus{
+				ConfigurationSyncStateSummary: aws.String(networkfirewall.ConfigurationSyncStateInSync),
+				SyncStates: map[string]*networkfirewall.SyncState{
+					"us-west-2a": syncState(networkfirewall.PerObjectSyncStatusInSync, updateToken),  //lintignore:AWSAT003
+					"us-west-2b": syncState(networkfirewall.PerObjectSyncStatusPending, updateToken), //lintignore:AWSAT003
+				},
+			},
+			want: networkfirewall.ConfigurationSyncStatePending,
+		},
+		"previous version in sync": {
+			status: &networkfirewall.FirewallStatus{
+				ConfigurationSyncStateSummary: aws.String(networkfirewall.ConfigurationSyncStateInSync),
+				SyncStates: map[string]*networkfirewall.SyncState{
+					"us-west-2a": syncState(networkfirewall.PerObjectSyncStatusInSync, updateToken), //lintignore:AWSAT003
+					"us-west-2b": syncState(networkfirewall.PerObjectSyncStatusInSync, "old"),       //lintignore:AWSAT003
+				},
+			},
+			want: networkfirewall.ConfigurationSyncStatePending,
+		},
+		"policy not reported": {
+			status: &networkfirewall.FirewallStatus{
+				ConfigurationSyncStateSummary: aws.String(networkfirewall.ConfigurationSyncStateInSync),
+				SyncStates: map[string]*networkfirewall.SyncState{
+					"us-west-2a": {}, //lintignore:AWSAT003
+				},
+			},
+			want: networkfirewall.ConfigurationSyncStatePending,
+		},
+		"other object pending": {
+			status: &networkfirewall.FirewallStatus{
+				ConfigurationSyncStateSummary: aws.String(networkfirewall.ConfigurationSyncStatePending),
+				SyncStates: map[string]*networkfirewall.SyncState{
+					"us-west-2a": syncState(networkfirewall.PerObjectSyncStatusInSync, updateToken), //lintignore:AWSAT003
+				},
+			},
+			want: networkfirewall.ConfigurationSyncStateInSync,
+		},
+		"no sync states": {
+			status: &networkfirewall.FirewallStatus{
+				ConfigurationSyncStateSummary: aws.String(networkfirewall.ConfigurationSyncStateCapacityConstrained),
+			},
+			want: networkfirewall.ConfigurationSyncStateCapacityConstrained,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfnetworkfirewall.FirewallPolicySyncState(testCase.status, policyARN, updateToken), testCase.want; got != want {
+				t.Errorf("FirewallPolicySyncState() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
 func testAccCheckFirewallPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -1222,6 +1486,36 @@ resource "aws_networkfirewall_firewall_policy" "test" {
 `, rName)
 }
 
+func testAccFirewallPolicyConfig_waitForFirewallSync(rName, fragmentDefaultAction string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name                   = %[1]q
+  wait_for_firewall_sync = true
+
+  firewall_policy {
+    stateless_fragment_default_actions = [%[2]q]
+    stateless_default_actions          = ["aws:pass"]
+  }
+}
+
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test[0].id
+  }
+}
+
+data "aws_networkfirewall_firewall" "test" {
+  arn = aws_networkfirewall_firewall.test.arn
+
+  depends_on = [aws_networkfirewall_firewall_policy.test]
+}
+`, rName, fragmentDefaultAction))
+}
+
 func testAccFirewallPolicyConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_firewall_policy" "test" {
@@ -1487,6 +1781,25 @@ resource "aws_networkfirewall_firewall_policy" "test" {
 `, rName, priority))
 }
 
+func testAccFirewallPolicyConfig_minCapacityHeadroom(rName string, headroom int) string {
+	return acctest.ConfigCompose(testAccFirewallPolicyConfig_baseStatelessRuleGroup(rName, 1), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall_policy" "test" {
+  name                  = %[1]q
+  min_capacity_headroom = %[2]d
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+
+    stateless_rule_group_reference {
+      priority     = 1
+      resource_arn = aws_networkfirewall_rule_group.test[0].arn
+    }
+  }
+}
+`, rName, headroom))
+}
+
 func testAccFirewallPolicyConfig_multipleStatelessRuleGroupReferences(rName string) string {
 	return acctest.ConfigCompose(testAccFirewallPolicyConfig_baseStatelessRuleGroup(rName, 2), fmt.Sprintf(`
 resource "aws_networkfirewall_firewall_policy" "test" {
@@ -1552,6 +1865,50 @@ resource "aws_networkfirewall_firewall_policy" "test" {
 `, rName)
 }
 
+func testAccFirewallPolicyConfig_statelessCustomActionShared(rName, secondActionName string) string {
+	return fmt.Sprintf(`
+locals {
+  custom_actions = [
+    {
+      name  = "CustomAction1"
+      value = "example-1"
+    },
+    {
+      name  = %[2]q
+      value = "example-2"
+    },
+  ]
+}
+
+resource "aws_networkfirewall_firewall_policy" "test" {
+  count = 2
+
+  name = "${%[1]q}-${count.index}"
+
+  firewall_policy {
+    stateless_fragment_default_actions = ["aws:drop"]
+    stateless_default_actions          = ["aws:pass"]
+
+    dynamic "stateless_custom_action" {
+      for_each = local.custom_actions
+
+      content {
+        action_name = stateless_custom_action.value.name
+
+        action_definition {
+          publish_metric_action {
+            dimension {
+              value = stateless_custom_action.value.value
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, secondActionName)
+}
+
 func testAccFirewallPolicyConfig_updateStatelessCustomAction(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_firewall_policy" "test" {
@@ -6,11 +6,16 @@ package networkfirewall_test
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -47,7 +52,8 @@ func TestAccNetworkFirewallFirewall_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "1"),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_status.0.sync_states.*.availability_zone", subnetResourceName, names.AttrAvailabilityZone),
 					resource.TestMatchTypeSetElemNestedAttrs(resourceName, "firewall_status.0.sync_states.*", map[string]*regexp.Regexp{
-						"attachment.0.endpoint_id": regexache.MustCompile(`vpce-`),
+						"attachment.0.endpoint_id":          regexache.MustCompile(`vpce-`),
+						"attachment.0.network_interface_id": regexache.MustCompile(`eni-`),
 					}),
 					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_status.0.sync_states.*.attachment.0.subnet_id", subnetResourceName, names.AttrID),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
@@ -70,6 +76,41 @@ func TestAccNetworkFirewallFirewall_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallFirewall_asyncCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall.test"
+	dataSourceName := "data.aws_networkfirewall_firewall.test"
+	waiterResourceName := "aws_async_waiter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig_asyncCreate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "async_create", "true"),
+					resource.TestCheckResourceAttrPair(waiterResourceName, "resource_id", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "firewall_status.0.status", networkfirewall.FirewallStatusValueReady),
+					resource.TestMatchTypeSetElemNestedAttrs(dataSourceName, "firewall_status.0.sync_states.*", map[string]*regexp.Regexp{
+						"attachment.0.endpoint_id": regexache.MustCompile(`vpce-`),
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"async_create", "firewall_status"},
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallFirewall_dualstackSubnet(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -359,6 +400,187 @@ func TestAccNetworkFirewallFirewall_SubnetMappings_updateMultipleSubnets(t *test
 	})
 }
 
+func TestAccNetworkFirewallFirewall_SubnetMappings_disableSubnet(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_firewall.test"
+	subnetResourceName := "aws_subnet.test.0"
+	updateSubnetResourceName := "aws_subnet.example"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig_subnetMappingEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "2"),
+				),
+			},
+			{
+				Config: testAccFirewallConfig_subnetMappingEnabled(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_status.0.sync_states.*.attachment.0.subnet_id", subnetResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subnet_mapping.*", map[string]string{
+						names.AttrEnabled: "false",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "subnet_mapping.*.subnet_id", updateSubnetResourceName, names.AttrID),
+				),
+			},
+			{
+				Config: testAccFirewallConfig_subnetMappingEnabled(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFirewallExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "firewall_status.0.sync_states.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "firewall_status.0.sync_states.*.attachment.0.subnet_id", updateSubnetResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "subnet_mapping.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallFirewall_SubnetMappings_noAvailableIPAddresses(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFirewallDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirewallConfig_fullSubnetBase(rName),
+			},
+			{
+				Config:      testAccFirewallConfig_fullSubnet(rName),
+				ExpectError: regexache.MustCompile(`subnet \(subnet-[0-9a-z]+\) has 0 available IP addresses, but a firewall endpoint requires 1`),
+			},
+		},
+	})
+}
+
+func TestSubnetMappingsToAssociate(t *testing.T) {
+	t.Parallel()
+
+	newSet := func(tfList ...map[string]interface{}) *schema.Set {
+		set := tfnetworkfirewall.ResourceFirewall().SchemaMap()["subnet_mapping"].ZeroValue().(*schema.Set)
+		for _, v := range tfList {
+			set.Add(v)
+		}
+		return set
+	}
+	mapping := func(subnetID string, enabled bool) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrEnabled:  enabled,
+			"ip_address_type":  networkfirewall.IPAddressTypeIpv4,
+			names.AttrSubnetID: subnetID,
+		}
+	}
+
+	testCases := map[string]struct {
+		old, new *schema.Set
+		want     []string
+	}{
+		"create": {
+			old:  newSet(),
+			new:  newSet(mapping("subnet-2", true), mapping("subnet-1", true)),
+			want: []string{"subnet-1", "subnet-2"},
+		},
+		"add": {
+			old:  newSet(mapping("subnet-1", true)),
+			new:  newSet(mapping("subnet-1", true), mapping("subnet-2", true)),
+			want: []string{"subnet-2"},
+		},
+		"remove": {
+			old:  newSet(mapping("subnet-1", true), mapping("subnet-2", true)),
+			new:  newSet(mapping("subnet-1", true)),
+			want: nil,
+		},
+		"enable": {
+			old:  newSet(mapping("subnet-1", true), mapping("subnet-2", false)),
+			new:  newSet(mapping("subnet-1", true), mapping("subnet-2", true)),
+			want: []string{"subnet-2"},
+		},
+		"add disabled": {
+			old:  newSet(mapping("subnet-1", true)),
+			new:  newSet(mapping("subnet-1", true), mapping("subnet-2", false)),
+			want: nil,
+		},
+		"unknown": {
+			old:  newSet(),
+			new:  newSet(mapping("74D93920-ED26-11E3-AC10-0800200C9A66", true)),
+			want: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfnetworkfirewall.SubnetMappingsToAssociate(testCase.old, testCase.new), testCase.want; !reflect.DeepEqual(got, want) {
+				t.Errorf("SubnetMappingsToAssociate() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckSubnetsAvailableIPAddresses(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		subnets []*ec2.Subnet
+		wantErr string
+	}{
+		"available": {
+			subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-1"), AvailableIpAddressCount: aws.Int64(1)},
+				{SubnetId: aws.String("subnet-2"), AvailableIpAddressCount: aws.Int64(250)},
+			},
+		},
+		"exhausted": {
+			subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-1"), AvailableIpAddressCount: aws.Int64(10)},
+				{SubnetId: aws.String("subnet-2"), AvailableIpAddressCount: aws.Int64(0)},
+			},
+			wantErr: "subnet (subnet-2) has 0 available IP addresses",
+		},
+		"IPv6-only": {
+			subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-1"), AvailableIpAddressCount: aws.Int64(0), Ipv6Native: aws.Bool(true)},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfnetworkfirewall.CheckSubnetsAvailableIPAddresses(testCase.subnets)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error = %v, want error containing %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
 func TestAccNetworkFirewallFirewall_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -514,6 +736,30 @@ resource "aws_networkfirewall_firewall" "test" {
 `, rName))
 }
 
+func testAccFirewallConfig_asyncCreate(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_base(rName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  async_create        = true
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test[0].id
+  }
+}
+
+resource "aws_async_waiter" "test" {
+  resource_type = "aws_networkfirewall_firewall"
+  resource_id   = aws_networkfirewall_firewall.test.arn
+}
+
+data "aws_networkfirewall_firewall" "test" {
+  arn = aws_async_waiter.test.id
+}
+`, rName))
+}
+
 func testAccFirewallConfig_deleteProtection(rName string, deleteProtection bool) string {
 	return acctest.ConfigCompose(testAccFirewallConfig_base(rName), fmt.Sprintf(`
 resource "aws_networkfirewall_firewall" "test" {
@@ -645,6 +891,39 @@ resource "aws_networkfirewall_firewall" "test" {
 `, rName))
 }
 
+func testAccFirewallConfig_subnetMappingEnabled(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_base(rName), fmt.Sprintf(`
+resource "aws_subnet" "example" {
+  availability_zone = data.aws_availability_zones.available.names[1]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 8, 1)
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.test[0].id
+  }
+
+  subnet_mapping {
+    enabled   = %[2]t
+    subnet_id = aws_subnet.example.id
+  }
+
+  timeouts {
+    update = "1h"
+  }
+}
+`, rName, enabled))
+}
+
 func testAccFirewallConfig_encryptionConfiguration(rName, description string) string {
 	return acctest.ConfigCompose(testAccFirewallConfig_base(rName), fmt.Sprintf(`
 resource "aws_kms_key" "test" {}
@@ -690,3 +969,42 @@ resource "aws_networkfirewall_firewall" "test" {
 }
 `, rName))
 }
+
+func testAccFirewallConfig_fullSubnetBase(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_base(rName), fmt.Sprintf(`
+# A /28 subnet has 11 usable IP addresses.
+resource "aws_subnet" "full" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = cidrsubnet(aws_vpc.test.cidr_block, 12, 4000)
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "full" {
+  count = 11
+
+  subnet_id = aws_subnet.full.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccFirewallConfig_fullSubnet(rName string) string {
+	return acctest.ConfigCompose(testAccFirewallConfig_fullSubnetBase(rName), fmt.Sprintf(`
+resource "aws_networkfirewall_firewall" "test" {
+  name                = %[1]q
+  firewall_policy_arn = aws_networkfirewall_firewall_policy.test.arn
+  vpc_id              = aws_vpc.test.id
+
+  subnet_mapping {
+    subnet_id = aws_subnet.full.id
+  }
+}
+`, rName))
+}
@@ -0,0 +1,1119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+)
+
+// The flow evaluator approximates how a firewall policy handles individual
+// sample flows without sending any traffic. Stateless rule groups are
+// evaluated from their match attributes, stateful rule groups from their
+// domain lists, 5-tuple rules or Suricata compatible rule strings.
+// Rules that depend on packet payloads or protocol detection beyond
+// HTTP host and TLS SNI cannot be evaluated locally and are reported as such.
+
+const (
+	flowEvaluationEngineStateless = "STATELESS"
+	flowEvaluationEngineStateful  = "STATEFUL"
+)
+
+const (
+	flowProtocolICMP = "ICMP"
+	flowProtocolTCP  = "TCP"
+	flowProtocolUDP  = "UDP"
+)
+
+func flowProtocol_Values() []string {
+	return []string{
+		flowProtocolICMP,
+		flowProtocolTCP,
+		flowProtocolUDP,
+	}
+}
+
+const (
+	statefulDefaultActionAlertEstablished = "aws:alert_established"
+	statefulDefaultActionAlertStrict      = "aws:alert_strict"
+	statefulDefaultActionDropEstablished  = "aws:drop_established"
+	statefulDefaultActionDropStrict       = "aws:drop_strict"
+)
+
+const (
+	statelessActionDrop = "aws:drop"
+	statelessActionPass = "aws:pass"
+)
+
+type flowSample struct {
+	protocol        string
+	source          netip.Addr
+	sourcePort      int
+	destination     netip.Addr
+	destinationPort int
+	httpHost        string
+	tlsSNI          string
+}
+
+func (f *flowSample) protocolNumber() int64 {
+	switch f.protocol {
+	case flowProtocolICMP:
+		return 1
+	case flowProtocolTCP:
+		return 6
+	case flowProtocolUDP:
+		return 17
+	}
+
+	return -1
+}
+
+type flowVerdict struct {
+	action       string
+	alert        bool
+	engine       string
+	ruleGroupARN string
+	matchedRule  string
+}
+
+type policyEvaluator struct {
+	ruleOrder                string
+	statefulDefaultActions   []string
+	statefulRuleGroups       []*statefulRuleGroupEvaluation
+	statelessDefaultActions  []string
+	statelessRuleGroups      []*statelessRuleGroupEvaluation
+	unevaluatedRuleGroupARNs map[string]struct{}
+}
+
+type statelessRuleGroupEvaluation struct {
+	arn   string
+	rules []*networkfirewall.StatelessRule
+}
+
+type statefulRuleGroupEvaluation struct {
+	arn         string
+	dropToAlert bool
+	rules       []statefulRule
+}
+
+type statefulRule interface {
+	action() string
+	id() string
+	match(*flowSample) bool
+}
+
+// newPolicyEvaluator compiles a firewall policy and the definitions of the rule groups it references.
+// Rule groups missing from ruleGroups (for example AWS managed rule groups) are reported as unevaluated.
+func newPolicyEvaluator(policy *networkfirewall.FirewallPolicy, ruleGroups map[string]*networkfirewall.RuleGroup) *policyEvaluator {
+	e := &policyEvaluator{
+		ruleOrder:                networkfirewall.RuleOrderDefaultActionOrder,
+		statefulDefaultActions:   aws.StringValueSlice(policy.StatefulDefaultActions),
+		statelessDefaultActions:  aws.StringValueSlice(policy.StatelessDefaultActions),
+		unevaluatedRuleGroupARNs: make(map[string]struct{}),
+	}
+
+	if v := policy.StatefulEngineOptions; v != nil && v.RuleOrder != nil {
+		e.ruleOrder = aws.StringValue(v.RuleOrder)
+	}
+
+	var policyIPSets map[string]*networkfirewall.IPSet
+	if v := policy.PolicyVariables; v != nil {
+		policyIPSets = v.RuleVariables
+	}
+
+	statelessReferences := append([]*networkfirewall.StatelessRuleGroupReference{}, policy.StatelessRuleGroupReferences...)
+	sort.SliceStable(statelessReferences, func(i, j int) bool {
+		return aws.Int64Value(statelessReferences[i].Priority) < aws.Int64Value(statelessReferences[j].Priority)
+	})
+
+	for _, reference := range statelessReferences {
+		arn := aws.StringValue(reference.ResourceArn)
+		ruleGroup, ok := ruleGroups[arn]
+
+		if !ok || ruleGroup == nil || ruleGroup.RulesSource == nil || ruleGroup.RulesSource.StatelessRulesAndCustomActions == nil {
+			e.unevaluatedRuleGroupARNs[arn] = struct{}{}
+			continue
+		}
+
+		rules := append([]*networkfirewall.StatelessRule{}, ruleGroup.RulesSource.StatelessRulesAndCustomActions.StatelessRules...)
+		sort.SliceStable(rules, func(i, j int) bool {
+			return aws.Int64Value(rules[i].Priority) < aws.Int64Value(rules[j].Priority)
+		})
+
+		e.statelessRuleGroups = append(e.statelessRuleGroups, &statelessRuleGroupEvaluation{
+			arn:   arn,
+			rules: rules,
+		})
+	}
+
+	statefulReferences := append([]*networkfirewall.StatefulRuleGroupReference{}, policy.StatefulRuleGroupReferences...)
+	if e.ruleOrder == networkfirewall.RuleOrderStrictOrder {
+		sort.SliceStable(statefulReferences, func(i, j int) bool {
+			return aws.Int64Value(statefulReferences[i].Priority) < aws.Int64Value(statefulReferences[j].Priority)
+		})
+	}
+
+	for _, reference := range statefulReferences {
+		arn := aws.StringValue(reference.ResourceArn)
+		ruleGroup, ok := ruleGroups[arn]
+
+		if !ok || ruleGroup == nil || ruleGroup.RulesSource == nil {
+			e.unevaluatedRuleGroupARNs[arn] = struct{}{}
+			continue
+		}
+
+		rules, complete := compileStatefulRules(ruleGroup, policyIPSets)
+
+		if !complete {
+			e.unevaluatedRuleGroupARNs[arn] = struct{}{}
+		}
+
+		e.statefulRuleGroups = append(e.statefulRuleGroups, &statefulRuleGroupEvaluation{
+			arn:         arn,
+			dropToAlert: reference.Override != nil && aws.StringValue(reference.Override.Action) == networkfirewall.OverrideActionDropToAlert,
+			rules:       rules,
+		})
+	}
+
+	return e
+}
+
+func (e *policyEvaluator) unevaluatedRuleGroups() []string {
+	arns := make([]string, 0, len(e.unevaluatedRuleGroupARNs))
+
+	for arn := range e.unevaluatedRuleGroupARNs {
+		arns = append(arns, arn)
+	}
+
+	sort.Strings(arns)
+
+	return arns
+}
+
+func (e *policyEvaluator) evaluate(f *flowSample) *flowVerdict {
+	for _, ruleGroup := range e.statelessRuleGroups {
+		for _, rule := range ruleGroup.rules {
+			if rule.RuleDefinition == nil || !statelessRuleMatches(rule.RuleDefinition.MatchAttributes, f) {
+				continue
+			}
+
+			actions := aws.StringValueSlice(rule.RuleDefinition.Actions)
+			matchedRule := fmt.Sprintf("priority:%d", aws.Int64Value(rule.Priority))
+
+			if verdict := statelessVerdict(actions, ruleGroup.arn, matchedRule); verdict != nil {
+				return verdict
+			}
+
+			return e.evaluateStateful(f)
+		}
+	}
+
+	if verdict := statelessVerdict(e.statelessDefaultActions, "", ""); verdict != nil {
+		return verdict
+	}
+
+	return e.evaluateStateful(f)
+}
+
+// statelessVerdict returns the verdict for a terminal stateless action, or nil when the flow is forwarded to the stateful engine.
+func statelessVerdict(actions []string, ruleGroupARN, matchedRule string) *flowVerdict {
+	for _, action := range actions {
+		switch action {
+		case statelessActionPass:
+			return &flowVerdict{
+				action:       networkfirewall.StatefulActionPass,
+				engine:       flowEvaluationEngineStateless,
+				ruleGroupARN: ruleGroupARN,
+				matchedRule:  matchedRule,
+			}
+		case statelessActionDrop:
+			return &flowVerdict{
+				action:       networkfirewall.StatefulActionDrop,
+				engine:       flowEvaluationEngineStateless,
+				ruleGroupARN: ruleGroupARN,
+				matchedRule:  matchedRule,
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *policyEvaluator) evaluateStateful(f *flowSample) *flowVerdict {
+	if e.ruleOrder == networkfirewall.RuleOrderStrictOrder {
+		return e.evaluateStatefulStrictOrder(f)
+	}
+
+	return e.evaluateStatefulActionOrder(f)
+}
+
+func (e *policyEvaluator) evaluateStatefulStrictOrder(f *flowSample) *flowVerdict {
+	verdict := &flowVerdict{
+		action: networkfirewall.StatefulActionPass,
+		engine: flowEvaluationEngineStateful,
+	}
+
+	for _, ruleGroup := range e.statefulRuleGroups {
+		for _, rule := range ruleGroup.rules {
+			if !rule.match(f) {
+				continue
+			}
+
+			action := ruleGroup.effectiveAction(rule)
+
+			if action == networkfirewall.StatefulActionAlert {
+				verdict.alert = true
+				continue
+			}
+
+			verdict.action = action
+			verdict.ruleGroupARN = ruleGroup.arn
+			verdict.matchedRule = rule.id()
+			if action != networkfirewall.StatefulActionPass {
+				verdict.alert = true
+			}
+
+			return verdict
+		}
+	}
+
+	// Sample flows are evaluated as established connections, so the "established" default actions apply to them.
+	for _, action := range e.statefulDefaultActions {
+		switch action {
+		case statefulDefaultActionDropStrict, statefulDefaultActionDropEstablished:
+			verdict.action = networkfirewall.StatefulActionDrop
+		case statefulDefaultActionAlertStrict, statefulDefaultActionAlertEstablished:
+			verdict.alert = true
+		}
+	}
+
+	return verdict
+}
+
+func (e *policyEvaluator) evaluateStatefulActionOrder(f *flowSample) *flowVerdict {
+	verdict := &flowVerdict{
+		action: networkfirewall.StatefulActionPass,
+		engine: flowEvaluationEngineStateful,
+	}
+
+	for _, action := range []string{
+		networkfirewall.StatefulActionPass,
+		networkfirewall.StatefulActionDrop,
+		networkfirewall.StatefulActionReject,
+		networkfirewall.StatefulActionAlert,
+	} {
+		for _, ruleGroup := range e.statefulRuleGroups {
+			for _, rule := range ruleGroup.rules {
+				if ruleGroup.effectiveAction(rule) != action || !rule.match(f) {
+					continue
+				}
+
+				verdict.ruleGroupARN = ruleGroup.arn
+				verdict.matchedRule = rule.id()
+
+				if action == networkfirewall.StatefulActionAlert {
+					verdict.alert = true
+					return verdict
+				}
+
+				verdict.action = action
+				verdict.alert = action != networkfirewall.StatefulActionPass
+
+				return verdict
+			}
+		}
+	}
+
+	return verdict
+}
+
+func (g *statefulRuleGroupEvaluation) effectiveAction(rule statefulRule) string {
+	action := rule.action()
+
+	if g.dropToAlert && action == networkfirewall.StatefulActionDrop {
+		return networkfirewall.StatefulActionAlert
+	}
+
+	return action
+}
+
+func statelessRuleMatches(apiObject *networkfirewall.MatchAttributes, f *flowSample) bool {
+	if apiObject == nil {
+		return false
+	}
+
+	// TCP flags can't be inferred from a sample flow.
+	if len(apiObject.TCPFlags) > 0 {
+		return false
+	}
+
+	if len(apiObject.Protocols) > 0 {
+		var found bool
+
+		for _, v := range apiObject.Protocols {
+			if aws.Int64Value(v) == f.protocolNumber() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	if !addressesContain(apiObject.Sources, f.source) || !addressesContain(apiObject.Destinations, f.destination) {
+		return false
+	}
+
+	if f.protocol == flowProtocolTCP || f.protocol == flowProtocolUDP {
+		if !portRangesContain(apiObject.SourcePorts, f.sourcePort) || !portRangesContain(apiObject.DestinationPorts, f.destinationPort) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func addressesContain(apiObjects []*networkfirewall.Address, addr netip.Addr) bool {
+	if len(apiObjects) == 0 {
+		return true
+	}
+
+	for _, apiObject := range apiObjects {
+		prefix, err := netip.ParsePrefix(aws.StringValue(apiObject.AddressDefinition))
+
+		if err == nil && prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func portRangesContain(apiObjects []*networkfirewall.PortRange, port int) bool {
+	if len(apiObjects) == 0 {
+		return true
+	}
+
+	for _, apiObject := range apiObjects {
+		if int64(port) >= aws.Int64Value(apiObject.FromPort) && int64(port) <= aws.Int64Value(apiObject.ToPort) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// compileStatefulRules converts a stateful rule group into evaluable rules.
+// The returned boolean is false if any of the group's rules could not be compiled.
+func compileStatefulRules(ruleGroup *networkfirewall.RuleGroup, policyIPSets map[string]*networkfirewall.IPSet) ([]statefulRule, bool) {
+	rulesSource := ruleGroup.RulesSource
+	variables := newRuleVariables(ruleGroup.RuleVariables, policyIPSets)
+
+	if v := rulesSource.RulesSourceList; v != nil {
+		return compileDomainListRules(v), true
+	}
+
+	var rules []statefulRule
+	complete := true
+
+	for _, v := range rulesSource.StatefulRules {
+		if v.Header == nil {
+			complete = false
+			continue
+		}
+
+		direction := "->"
+		if aws.StringValue(v.Header.Direction) == networkfirewall.StatefulRuleDirectionAny {
+			direction = "<>"
+		}
+
+		var options []string
+		for _, option := range v.RuleOptions {
+			if settings := aws.StringValueSlice(option.Settings); len(settings) > 0 {
+				options = append(options, aws.StringValue(option.Keyword)+":"+strings.Join(settings, ","))
+			} else {
+				options = append(options, aws.StringValue(option.Keyword))
+			}
+		}
+
+		rule, err := compileSuricataRule([]string{
+			aws.StringValue(v.Action),
+			aws.StringValue(v.Header.Protocol),
+			aws.StringValue(v.Header.Source),
+			aws.StringValue(v.Header.SourcePort),
+			direction,
+			aws.StringValue(v.Header.Destination),
+			aws.StringValue(v.Header.DestinationPort),
+		}, options, variables)
+
+		if err != nil {
+			complete = false
+			continue
+		}
+
+		rules = append(rules, rule)
+	}
+
+	if v := aws.StringValue(rulesSource.RulesString); v != "" {
+		for _, line := range splitSuricataRules(v) {
+			rule, err := parseSuricataRule(line, variables)
+
+			if err != nil {
+				complete = false
+				continue
+			}
+
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, complete
+}
+
+type domainListRule struct {
+	ruleAction  string
+	allowlist   bool
+	targetTypes []string
+	targets     []string
+}
+
+func compileDomainListRules(apiObject *networkfirewall.RulesSourceList) []statefulRule {
+	targetTypes := aws.StringValueSlice(apiObject.TargetTypes)
+	targets := aws.StringValueSlice(apiObject.Targets)
+
+	if aws.StringValue(apiObject.GeneratedRulesType) == networkfirewall.GeneratedRulesTypeAllowlist {
+		return []statefulRule{
+			&domainListRule{ruleAction: networkfirewall.StatefulActionPass, allowlist: true, targetTypes: targetTypes, targets: targets},
+			&domainListRule{ruleAction: networkfirewall.StatefulActionDrop, allowlist: false, targetTypes: targetTypes, targets: targets},
+		}
+	}
+
+	return []statefulRule{
+		&domainListRule{ruleAction: networkfirewall.StatefulActionDrop, allowlist: true, targetTypes: targetTypes, targets: targets},
+	}
+}
+
+func (r *domainListRule) action() string {
+	return r.ruleAction
+}
+
+func (r *domainListRule) id() string {
+	if r.allowlist {
+		return "domain_list:match"
+	}
+
+	return "domain_list:default"
+}
+
+// match reports whether the flow's HTTP host or TLS SNI matches (or, for the generated default rule, does not match) the domain list.
+func (r *domainListRule) match(f *flowSample) bool {
+	if f.protocol != flowProtocolTCP {
+		return false
+	}
+
+	for _, targetType := range r.targetTypes {
+		var value string
+
+		switch targetType {
+		case networkfirewall.TargetTypeHttpHost:
+			value = f.httpHost
+		case networkfirewall.TargetTypeTlsSni:
+			value = f.tlsSNI
+		}
+
+		if value == "" {
+			continue
+		}
+
+		matched := false
+		for _, target := range r.targets {
+			if domainMatches(target, value) {
+				matched = true
+				break
+			}
+		}
+
+		if matched == r.allowlist {
+			return true
+		}
+	}
+
+	return false
+}
+
+// domainMatches implements the Network Firewall domain list semantics, where a leading '.' matches the domain and all of its subdomains.
+func domainMatches(target, domain string) bool {
+	target, domain = strings.ToLower(target), strings.ToLower(domain)
+
+	if strings.HasPrefix(target, ".") {
+		return domain == target[1:] || strings.HasSuffix(domain, target)
+	}
+
+	return domain == target
+}
+
+type ruleVariables struct {
+	ipSets   map[string][]string
+	portSets map[string][]string
+}
+
+// newRuleVariables merges a rule group's variables with the policy variables.
+// Variables defined in the rule group take precedence over policy variables.
+func newRuleVariables(apiObject *networkfirewall.RuleVariables, policyIPSets map[string]*networkfirewall.IPSet) *ruleVariables {
+	v := &ruleVariables{
+		ipSets:   make(map[string][]string),
+		portSets: make(map[string][]string),
+	}
+
+	for name, ipSet := range policyIPSets {
+		if ipSet != nil {
+			v.ipSets[name] = aws.StringValueSlice(ipSet.Definition)
+		}
+	}
+
+	if apiObject == nil {
+		return v
+	}
+
+	for name, ipSet := range apiObject.IPSets {
+		if ipSet != nil {
+			v.ipSets[name] = aws.StringValueSlice(ipSet.Definition)
+		}
+	}
+
+	for name, portSet := range apiObject.PortSets {
+		if portSet != nil {
+			v.portSets[name] = aws.StringValueSlice(portSet.Definition)
+		}
+	}
+
+	return v
+}
+
+// splitSuricataRules splits a rules string into individual rules, joining continuation lines and dropping comments.
+func splitSuricataRules(s string) []string {
+	var rules []string
+	var current strings.Builder
+
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+
+		if current.Len() == 0 && (line == "" || strings.HasPrefix(line, "#")) {
+			continue
+		}
+
+		if strings.HasSuffix(line, `\`) {
+			current.WriteString(strings.TrimSuffix(line, `\`))
+			continue
+		}
+
+		current.WriteString(line)
+		rules = append(rules, current.String())
+		current.Reset()
+	}
+
+	if current.Len() > 0 {
+		rules = append(rules, current.String())
+	}
+
+	return rules
+}
+
+type suricataRule struct {
+	ruleAction    string
+	protocol      string
+	source        addressMatcher
+	sourcePort    portMatcher
+	bidirectional bool
+	destination   addressMatcher
+	destPort      portMatcher
+	sid           string
+	contents      []contentMatch
+}
+
+type contentMatch struct {
+	buffer     string
+	value      string
+	negated    bool
+	noCase     bool
+	startsWith bool
+	endsWith   bool
+	dotPrefix  bool
+}
+
+func parseSuricataRule(s string, variables *ruleVariables) (*suricataRule, error) {
+	open, closing := strings.Index(s, "("), strings.LastIndex(s, ")")
+
+	if open < 0 || closing < open {
+		return nil, fmt.Errorf("rule options not found: %s", s)
+	}
+
+	header := strings.Fields(s[:open])
+
+	if len(header) != 7 {
+		return nil, fmt.Errorf("invalid rule header: %s", s[:open])
+	}
+
+	return compileSuricataRule(header, splitSuricataOptions(s[open+1:closing]), variables)
+}
+
+func splitSuricataOptions(s string) []string {
+	var options []string
+	var current strings.Builder
+	inQuotes, escaped := false, false
+
+	for _, r := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == ';' && !inQuotes:
+			if v := strings.TrimSpace(current.String()); v != "" {
+				options = append(options, v)
+			}
+			current.Reset()
+			continue
+		}
+
+		current.WriteRune(r)
+	}
+
+	if v := strings.TrimSpace(current.String()); v != "" {
+		options = append(options, v)
+	}
+
+	return options
+}
+
+func compileSuricataRule(header, options []string, variables *ruleVariables) (*suricataRule, error) {
+	rule := &suricataRule{}
+
+	switch action := strings.ToLower(header[0]); action {
+	case "pass", "drop", "alert":
+		rule.ruleAction = strings.ToUpper(action)
+	case "reject", "rejectsrc", "rejectdst", "rejectboth":
+		rule.ruleAction = networkfirewall.StatefulActionReject
+	default:
+		return nil, fmt.Errorf("unsupported rule action: %s", header[0])
+	}
+
+	switch protocol := strings.ToLower(header[1]); protocol {
+	case "ip", "tcp", "udp", "icmp", "http", "tls":
+		rule.protocol = protocol
+	default:
+		return nil, fmt.Errorf("unsupported rule protocol: %s", header[1])
+	}
+
+	var err error
+
+	if rule.source, err = parseAddressMatcher(header[2], variables); err != nil {
+		return nil, err
+	}
+
+	if rule.sourcePort, err = parsePortMatcher(header[3], variables); err != nil {
+		return nil, err
+	}
+
+	switch header[4] {
+	case "->":
+	case "<>":
+		rule.bidirectional = true
+	default:
+		return nil, fmt.Errorf("unsupported rule direction: %s", header[4])
+	}
+
+	if rule.destination, err = parseAddressMatcher(header[5], variables); err != nil {
+		return nil, err
+	}
+
+	if rule.destPort, err = parsePortMatcher(header[6], variables); err != nil {
+		return nil, err
+	}
+
+	var buffer string
+
+	for _, option := range options {
+		keyword, value, _ := strings.Cut(option, ":")
+		keyword, value = strings.ToLower(strings.TrimSpace(keyword)), strings.TrimSpace(value)
+
+		switch keyword {
+		case "classtype", "flow", "gid", "metadata", "msg", "priority", "reference", "rev":
+		case "sid":
+			rule.sid = value
+		case "http.host", "tls.sni":
+			buffer = keyword
+		case "content":
+			if buffer == "" {
+				return nil, fmt.Errorf("payload content matching is not supported: %s", option)
+			}
+
+			content := contentMatch{buffer: buffer}
+
+			if strings.HasPrefix(value, "!") {
+				content.negated = true
+				value = strings.TrimSpace(value[1:])
+			}
+
+			content.value = strings.Trim(value, `"`)
+			rule.contents = append(rule.contents, content)
+		case "nocase", "startswith", "endswith", "dotprefix":
+			if len(rule.contents) == 0 && keyword != "dotprefix" {
+				return nil, fmt.Errorf("content modifier without content: %s", option)
+			}
+
+			switch keyword {
+			case "nocase":
+				rule.contents[len(rule.contents)-1].noCase = true
+			case "startswith":
+				rule.contents[len(rule.contents)-1].startsWith = true
+			case "endswith":
+				rule.contents[len(rule.contents)-1].endsWith = true
+			case "dotprefix":
+				// dotprefix precedes the content it applies to.
+				rule.contents = append(rule.contents, contentMatch{buffer: buffer, dotPrefix: true})
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rule option: %s", keyword)
+		}
+	}
+
+	// Fold any dotprefix placeholders into the content that follows them.
+	var contents []contentMatch
+	dotPrefix := false
+	for _, content := range rule.contents {
+		if content.dotPrefix && content.value == "" {
+			dotPrefix = true
+			continue
+		}
+
+		content.dotPrefix = content.dotPrefix || dotPrefix
+		dotPrefix = false
+		contents = append(contents, content)
+	}
+	rule.contents = contents
+
+	return rule, nil
+}
+
+func (r *suricataRule) action() string {
+	return r.ruleAction
+}
+
+func (r *suricataRule) id() string {
+	if r.sid == "" {
+		return ""
+	}
+
+	return "sid:" + r.sid
+}
+
+func (r *suricataRule) match(f *flowSample) bool {
+	switch r.protocol {
+	case "tcp":
+		if f.protocol != flowProtocolTCP {
+			return false
+		}
+	case "udp":
+		if f.protocol != flowProtocolUDP {
+			return false
+		}
+	case "icmp":
+		if f.protocol != flowProtocolICMP {
+			return false
+		}
+	case "http":
+		if f.protocol != flowProtocolTCP || f.httpHost == "" {
+			return false
+		}
+	case "tls":
+		if f.protocol != flowProtocolTCP || f.tlsSNI == "" {
+			return false
+		}
+	}
+
+	portsApply := f.protocol == flowProtocolTCP || f.protocol == flowProtocolUDP
+	forward := r.source.match(f.source) && r.destination.match(f.destination) &&
+		(!portsApply || (r.sourcePort.match(f.sourcePort) && r.destPort.match(f.destinationPort)))
+	reverse := r.bidirectional && r.source.match(f.destination) && r.destination.match(f.source) &&
+		(!portsApply || (r.sourcePort.match(f.destinationPort) && r.destPort.match(f.sourcePort)))
+
+	if !forward && !reverse {
+		return false
+	}
+
+	for _, content := range r.contents {
+		if !content.match(f) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *contentMatch) match(f *flowSample) bool {
+	var value string
+
+	switch c.buffer {
+	case "http.host":
+		value = f.httpHost
+	case "tls.sni":
+		value = f.tlsSNI
+	}
+
+	if value == "" {
+		return false
+	}
+
+	needle := c.value
+	if c.dotPrefix {
+		value = "." + value
+	}
+	if c.noCase {
+		value, needle = strings.ToLower(value), strings.ToLower(needle)
+	}
+
+	var matched bool
+	switch {
+	case c.startsWith && c.endsWith:
+		matched = value == needle
+	case c.startsWith:
+		matched = strings.HasPrefix(value, needle)
+	case c.endsWith:
+		matched = strings.HasSuffix(value, needle)
+	default:
+		matched = strings.Contains(value, needle)
+	}
+
+	return matched != c.negated
+}
+
+type addressMatcher struct {
+	any      bool
+	negated  bool
+	prefixes []netip.Prefix
+	children []addressMatcher
+}
+
+func parseAddressMatcher(s string, variables *ruleVariables) (addressMatcher, error) {
+	s = strings.TrimSpace(s)
+
+	switch {
+	case strings.EqualFold(s, "any"):
+		return addressMatcher{any: true}, nil
+	case strings.HasPrefix(s, "!"):
+		m, err := parseAddressMatcher(s[1:], variables)
+		if err != nil {
+			return addressMatcher{}, err
+		}
+
+		return addressMatcher{negated: true, children: []addressMatcher{m}}, nil
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return addressMatcher{}, fmt.Errorf("invalid address list: %s", s)
+		}
+
+		var m addressMatcher
+		for _, v := range splitSuricataList(s[1 : len(s)-1]) {
+			child, err := parseAddressMatcher(v, variables)
+			if err != nil {
+				return addressMatcher{}, err
+			}
+
+			m.children = append(m.children, child)
+		}
+
+		return m, nil
+	case strings.HasPrefix(s, "$"):
+		definition, ok := variables.ipSets[s[1:]]
+		if !ok {
+			return addressMatcher{}, fmt.Errorf("undefined IP set variable: %s", s)
+		}
+
+		return parseAddressMatcher("["+strings.Join(definition, ",")+"]", variables)
+	}
+
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return addressMatcher{}, err
+		}
+
+		return addressMatcher{prefixes: []netip.Prefix{netip.PrefixFrom(addr, addr.BitLen())}}, nil
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return addressMatcher{}, err
+	}
+
+	return addressMatcher{prefixes: []netip.Prefix{prefix}}, nil
+}
+
+func (m *addressMatcher) match(addr netip.Addr) bool {
+	if m.any {
+		return true
+	}
+
+	if m.negated {
+		return !m.children[0].match(addr)
+	}
+
+	if len(m.children) == 0 {
+		for _, prefix := range m.prefixes {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return matchList(m.children, func(child addressMatcher) (bool, bool) { return child.negated, child.match(addr) })
+}
+
+type portMatcher struct {
+	any      bool
+	negated  bool
+	from, to int
+	children []portMatcher
+}
+
+func parsePortMatcher(s string, variables *ruleVariables) (portMatcher, error) {
+	s = strings.TrimSpace(s)
+
+	switch {
+	case strings.EqualFold(s, "any"):
+		return portMatcher{any: true}, nil
+	case strings.HasPrefix(s, "!"):
+		m, err := parsePortMatcher(s[1:], variables)
+		if err != nil {
+			return portMatcher{}, err
+		}
+
+		return portMatcher{negated: true, children: []portMatcher{m}}, nil
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return portMatcher{}, fmt.Errorf("invalid port list: %s", s)
+		}
+
+		m := portMatcher{from: -1, to: -1}
+		for _, v := range splitSuricataList(s[1 : len(s)-1]) {
+			child, err := parsePortMatcher(v, variables)
+			if err != nil {
+				return portMatcher{}, err
+			}
+
+			m.children = append(m.children, child)
+		}
+
+		return m, nil
+	case strings.HasPrefix(s, "$"):
+		definition, ok := variables.portSets[s[1:]]
+		if !ok {
+			return portMatcher{}, fmt.Errorf("undefined port set variable: %s", s)
+		}
+
+		return parsePortMatcher("["+strings.Join(definition, ",")+"]", variables)
+	}
+
+	from, to, isRange := strings.Cut(s, ":")
+	if !isRange {
+		to = from
+	}
+	if from == "" {
+		from = "0"
+	}
+	if to == "" {
+		to = "65535"
+	}
+
+	fromPort, err := strconv.Atoi(from)
+	if err != nil {
+		return portMatcher{}, fmt.Errorf("invalid port: %s", s)
+	}
+
+	toPort, err := strconv.Atoi(to)
+	if err != nil {
+		return portMatcher{}, fmt.Errorf("invalid port: %s", s)
+	}
+
+	return portMatcher{from: fromPort, to: toPort}, nil
+}
+
+func (m *portMatcher) match(port int) bool {
+	if m.any {
+		return true
+	}
+
+	if m.negated {
+		return !m.children[0].match(port)
+	}
+
+	if len(m.children) == 0 {
+		return port >= m.from && port <= m.to
+	}
+
+	return matchList(m.children, func(child portMatcher) (bool, bool) { return child.negated, child.match(port) })
+}
+
+// matchList implements Suricata list semantics: a value matches if it matches any positive entry and no negated entry.
+// A list consisting only of negated entries matches everything not excluded.
+func matchList[T any](children []T, f func(T) (bool, bool)) bool {
+	positives, matched := 0, false
+
+	for _, child := range children {
+		negated, ok := f(child)
+
+		if negated {
+			if !ok {
+				return false
+			}
+			continue
+		}
+
+		positives++
+		if ok {
+			matched = true
+		}
+	}
+
+	return matched || positives == 0
+}
+
+// splitSuricataList splits a comma-separated list, respecting nested brackets.
+func splitSuricataList(s string) []string {
+	var items []string
+	depth, start := 0, 0
+
+	for i, r := range s {
+		switch r {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+
+	if v := strings.TrimSpace(s[start:]); v != "" {
+		items = append(items, v)
+	}
+
+	return items
+}
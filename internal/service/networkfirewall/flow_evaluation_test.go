@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+)
+
+const (
+	testFlowEvaluationStatelessARN = "arn:aws:network-firewall:us-west-2:123456789012:stateless-rulegroup/stateless" //lintignore:AWSAT003,AWSAT005
+	testFlowEvaluationDomainsARN   = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/domains"    //lintignore:AWSAT003,AWSAT005
+	testFlowEvaluationSuricataARN  = "arn:aws:network-firewall:us-west-2:123456789012:stateful-rulegroup/suricata"   //lintignore:AWSAT003,AWSAT005
+	testFlowEvaluationManagedARN   = "arn:aws:network-firewall:us-west-2:aws-managed:stateful-rulegroup/managed"     //lintignore:AWSAT003,AWSAT005
+)
+
+func testFlowEvaluationRuleGroups() map[string]*networkfirewall.RuleGroup {
+	return map[string]*networkfirewall.RuleGroup{
+		testFlowEvaluationStatelessARN: {
+			RulesSource: &networkfirewall.RulesSource{
+				StatelessRulesAndCustomActions: &networkfirewall.StatelessRulesAndCustomActions{
+					StatelessRules: []*networkfirewall.StatelessRule{
+						{
+							Priority: aws.Int64(20),
+							RuleDefinition: &networkfirewall.RuleDefinition{
+								Actions: aws.StringSlice([]string{"aws:forward_to_sfe"}),
+								MatchAttributes: &networkfirewall.MatchAttributes{
+									Protocols: aws.Int64Slice([]int64{6}),
+								},
+							},
+						},
+						{
+							Priority: aws.Int64(10),
+							RuleDefinition: &networkfirewall.RuleDefinition{
+								Actions: aws.StringSlice([]string{"aws:drop"}),
+								MatchAttributes: &networkfirewall.MatchAttributes{
+									Sources: []*networkfirewall.Address{
+										{AddressDefinition: aws.String("198.51.100.0/24")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		testFlowEvaluationDomainsARN: {
+			RulesSource: &networkfirewall.RulesSource{
+				RulesSourceList: &networkfirewall.RulesSourceList{
+					GeneratedRulesType: aws.String(networkfirewall.GeneratedRulesTypeAllowlist),
+					TargetTypes:        aws.StringSlice([]string{networkfirewall.TargetTypeHttpHost, networkfirewall.TargetTypeTlsSni}),
+					Targets:            aws.StringSlice([]string{".example.com", "test.example.org"}),
+				},
+			},
+		},
+		testFlowEvaluationSuricataARN: {
+			RuleVariables: &networkfirewall.RuleVariables{
+				PortSets: map[string]*networkfirewall.PortSet{
+					"DB_PORTS": {Definition: aws.StringSlice([]string{"3306", "5432"})},
+				},
+			},
+			RulesSource: &networkfirewall.RulesSource{
+				RulesString: aws.String(`
+# Block database access from outside the home network.
+drop tcp !$HOME_NET any -> $HOME_NET $DB_PORTS (msg:"db"; sid:100; rev:1;)
+alert udp any any -> any 53 (msg:"dns"; \
+  sid:101;)
+pass tls $HOME_NET any -> any any (tls.sni; content:"example.net"; nocase; endswith; sid:102;)
+alert tcp any any -> any any (content:"GET"; sid:103;)
+`),
+			},
+		},
+	}
+}
+
+func testFlowEvaluationPolicy(ruleOrder string) *networkfirewall.FirewallPolicy {
+	return &networkfirewall.FirewallPolicy{
+		PolicyVariables: &networkfirewall.PolicyVariables{
+			RuleVariables: map[string]*networkfirewall.IPSet{
+				"HOME_NET": {Definition: aws.StringSlice([]string{"10.0.0.0/16"})},
+			},
+		},
+		StatefulDefaultActions: aws.StringSlice([]string{"aws:drop_established"}),
+		StatefulEngineOptions: &networkfirewall.StatefulEngineOptions{
+			RuleOrder: aws.String(ruleOrder),
+		},
+		StatefulRuleGroupReferences: []*networkfirewall.StatefulRuleGroupReference{
+			{Priority: aws.Int64(2), ResourceArn: aws.String(testFlowEvaluationDomainsARN)},
+			{Priority: aws.Int64(1), ResourceArn: aws.String(testFlowEvaluationSuricataARN)},
+			{Priority: aws.Int64(3), ResourceArn: aws.String(testFlowEvaluationManagedARN)},
+		},
+		StatelessDefaultActions: aws.StringSlice([]string{"aws:forward_to_sfe"}),
+		StatelessRuleGroupReferences: []*networkfirewall.StatelessRuleGroupReference{
+			{Priority: aws.Int64(1), ResourceArn: aws.String(testFlowEvaluationStatelessARN)},
+		},
+	}
+}
+
+func TestPolicyEvaluator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ruleOrder string
+		flow      flowSample
+		expected  flowVerdict
+	}{
+		"stateless drop": {
+			ruleOrder: networkfirewall.RuleOrderStrictOrder,
+			flow:      flowSample{protocol: flowProtocolTCP, source: netip.MustParseAddr("198.51.100.7"), destination: netip.MustParseAddr("10.0.1.5"), destinationPort: 443},
+			expected:  flowVerdict{action: "DROP", engine: flowEvaluationEngineStateless, ruleGroupARN: testFlowEvaluationStatelessARN, matchedRule: "priority:10"},
+		},
+		"strict suricata drop": {
+			ruleOrder: networkfirewall.RuleOrderStrictOrder,
+			flow:      flowSample{protocol: flowProtocolTCP, source: netip.MustParseAddr("192.0.2.1"), destination: netip.MustParseAddr("10.0.1.5"), destinationPort: 5432},
+			expected:  flowVerdict{action: "DROP", alert: true, engine: flowEvaluationEngineStateful, ruleGroupARN: testFlowEvaluationSuricataARN, matchedRule: "sid:100"},
+		},
+		"strict suricata pass": {
+			ruleOrder: networkfirewall.RuleOrderStrictOrder,
+			flow:      flowSample{protocol: flowProtocolTCP, source: netip.MustParseAddr("10.0.1.5"), destination: netip.MustParseAddr("192.0.2.1"), destinationPort: 443, tlsSNI: "www.EXAMPLE.net"},
+			expected:  flowVerdict{action: "PASS", engine: flowEvaluationEngineStateful, ruleGroupARN: testFlowEvaluationSuricataARN, matchedRule: "sid:102"},
+		},
+		"strict domain allowlist pass": {
+			ruleOrder: networkfirewall.RuleOrderStrictOrder,
+			flow:      flowSample{protocol: flowProtocolTCP, source: netip.MustParseAddr("10.0.1.5"), destination: netip.MustParseAddr("192.0.2.1"), destinationPort: 80, httpHost: "api.example.com"},
+			expected:  flowVerdict{action: "PASS", engine: flowEvaluationEngineStateful, ruleGroupARN: testFlowEvaluationDomainsARN, matchedRule: "domain_list:match"},
+		},
+		"strict domain allowlist drop": {
+			ruleOrder: networkfirewall.RuleOrderStrictOrder,
+			flow:      flowSample{protocol: flowProtocolTCP, source: netip.MustParseAddr("10.0.1.5"), destination: netip.MustParseAddr("192.0.2.1"), destinationPort: 80, httpHost: "other.example.org"},
+			expected:  flowVerdict{action: "DROP", alert: true, engine: flowEvaluationEngineStateful, ruleGroupARN: testFlowEvaluationDomainsARN, matchedRule: "domain_list:default"},
+		},
+		"strict alert then default drop": {
+			ruleOrder: networkfirewall.RuleOrderStrictOrder,
+			flow:      flowSample{protocol: flowProtocolUDP, source: netip.MustParseAddr("10.0.1.5"), destination: netip.MustParseAddr("192.0.2.53"), destinationPort: 53},
+			expected:  flowVerdict{action: "DROP", alert: true, engine: flowEvaluationEngineStateful},
+		},
+		"action order alert passes": {
+			ruleOrder: networkfirewall.RuleOrderDefaultActionOrder,
+			flow:      flowSample{protocol: flowProtocolUDP, source: netip.MustParseAddr("10.0.1.5"), destination: netip.MustParseAddr("192.0.2.53"), destinationPort: 53},
+			expected:  flowVerdict{action: "PASS", alert: true, engine: flowEvaluationEngineStateful, ruleGroupARN: testFlowEvaluationSuricataARN, matchedRule: "sid:101"},
+		},
+		"action order no match passes": {
+			ruleOrder: networkfirewall.RuleOrderDefaultActionOrder,
+			flow:      flowSample{protocol: flowProtocolICMP, source: netip.MustParseAddr("10.0.1.5"), destination: netip.MustParseAddr("192.0.2.1")},
+			expected:  flowVerdict{action: "PASS", engine: flowEvaluationEngineStateful},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator := newPolicyEvaluator(testFlowEvaluationPolicy(testCase.ruleOrder), testFlowEvaluationRuleGroups())
+			got := evaluator.evaluate(&testCase.flow)
+
+			if *got != testCase.expected {
+				t.Errorf("got %+v, expected %+v", *got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestPolicyEvaluatorUnevaluatedRuleGroups(t *testing.T) {
+	t.Parallel()
+
+	evaluator := newPolicyEvaluator(testFlowEvaluationPolicy(networkfirewall.RuleOrderStrictOrder), testFlowEvaluationRuleGroups())
+	got := evaluator.unevaluatedRuleGroups()
+	expected := []string{testFlowEvaluationSuricataARN, testFlowEvaluationManagedARN}
+
+	if len(got) != len(expected) {
+		t.Fatalf("got %v, expected %v", got, expected)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("got %v, expected %v", got, expected)
+		}
+	}
+}
+
+func TestParseSuricataAddressAndPortMatchers(t *testing.T) {
+	t.Parallel()
+
+	variables := &ruleVariables{
+		ipSets: map[string][]string{
+			"HOME_NET": {"10.0.0.0/8", "172.16.0.0/12"},
+		},
+		portSets: map[string][]string{
+			"WEB_PORTS": {"80", "8000:8080"},
+		},
+	}
+
+	addressTestCases := []struct {
+		spec     string
+		addr     string
+		expected bool
+	}{
+		{spec: "any", addr: "192.0.2.1", expected: true},
+		{spec: "$HOME_NET", addr: "172.16.3.4", expected: true},
+		{spec: "!$HOME_NET", addr: "10.1.2.3", expected: false},
+		{spec: "[10.0.0.0/8,!10.1.0.0/16]", addr: "10.1.2.3", expected: false},
+		{spec: "[10.0.0.0/8,!10.1.0.0/16]", addr: "10.2.2.3", expected: true},
+		{spec: "[!192.0.2.1]", addr: "192.0.2.2", expected: true},
+		{spec: "192.0.2.1", addr: "192.0.2.1", expected: true},
+	}
+
+	for _, testCase := range addressTestCases {
+		m, err := parseAddressMatcher(testCase.spec, variables)
+
+		if err != nil {
+			t.Fatalf("parsing %q: %s", testCase.spec, err)
+		}
+
+		if got := m.match(netip.MustParseAddr(testCase.addr)); got != testCase.expected {
+			t.Errorf("%q matching %s: got %t, expected %t", testCase.spec, testCase.addr, got, testCase.expected)
+		}
+	}
+
+	portTestCases := []struct {
+		spec     string
+		port     int
+		expected bool
+	}{
+		{spec: "any", port: 22, expected: true},
+		{spec: "$WEB_PORTS", port: 8008, expected: true},
+		{spec: "$WEB_PORTS", port: 443, expected: false},
+		{spec: "1024:", port: 65000, expected: true},
+		{spec: "!22", port: 22, expected: false},
+		{spec: "[1:1024,!22]", port: 80, expected: true},
+	}
+
+	for _, testCase := range portTestCases {
+		m, err := parsePortMatcher(testCase.spec, variables)
+
+		if err != nil {
+			t.Fatalf("parsing %q: %s", testCase.spec, err)
+		}
+
+		if got := m.match(testCase.port); got != testCase.expected {
+			t.Errorf("%q matching %d: got %t, expected %t", testCase.spec, testCase.port, got, testCase.expected)
+		}
+	}
+
+	if _, err := parseAddressMatcher("$EXTERNAL_NET", variables); err == nil {
+		t.Error("expected error for undefined variable")
+	}
+}
@@ -4,11 +4,20 @@
 package networkfirewall
 
 import (
+	"context"
+	"slices"
+
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -48,6 +57,50 @@ func expandEncryptionConfiguration(tfList []interface{}) *networkfirewall.Encryp
 	return ec
 }
 
+// checkEncryptionConfigurationKey checks that the customer managed key of an encryption configuration can be used by Network Firewall,
+// i.e. that it exists, is enabled and is a symmetric encryption key, so that a misconfigured key is reported before the resource is changed.
+// The check is skipped with a warning if the caller isn't allowed to describe the key.
+func checkEncryptionConfigurationKey(ctx context.Context, conn *kms.Client, apiObject *networkfirewall.EncryptionConfiguration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || aws.StringValue(apiObject.Type) != networkfirewall.EncryptionTypeCustomerKms {
+		return diags
+	}
+
+	keyID := aws.StringValue(apiObject.KeyId)
+	if keyID == "" {
+		return sdkdiag.AppendErrorf(diags, "encryption_configuration.key_id must be set when type is %q", networkfirewall.EncryptionTypeCustomerKms)
+	}
+
+	output, err := conn.DescribeKey(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	})
+
+	if errs.IsA[*kmstypes.NotFoundException](err) {
+		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) not found", keyID)
+	}
+
+	if tfawserr_sdkv2.ErrCodeEquals(err, "AccessDeniedException") {
+		return sdkdiag.AppendWarningf(diags, "KMS Key (%s) can't be described, skipping key checks. Network Firewall requires kms:DescribeKey, kms:CreateGrant, kms:GenerateDataKey and kms:Decrypt permissions on the key: %s", keyID, err)
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s): %s", keyID, err)
+	}
+
+	key := output.KeyMetadata
+
+	if key.KeyState != kmstypes.KeyStateEnabled {
+		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is %s, Network Firewall requires an enabled key", keyID, key.KeyState)
+	}
+
+	if key.KeySpec != kmstypes.KeySpecSymmetricDefault || key.KeyUsage != kmstypes.KeyUsageTypeEncryptDecrypt {
+		return sdkdiag.AppendErrorf(diags, "KMS Key (%s) is a %s key for %s, Network Firewall requires a %s key for %s", keyID, key.KeySpec, key.KeyUsage, kmstypes.KeySpecSymmetricDefault, kmstypes.KeyUsageTypeEncryptDecrypt)
+	}
+
+	return diags
+}
+
 func flattenEncryptionConfiguration(apiObject *networkfirewall.EncryptionConfiguration) []interface{} {
 	if apiObject == nil || apiObject.Type == nil {
 		return nil
@@ -90,6 +143,10 @@ func customActionSchema() *schema.Schema {
 													names.AttrValue: {
 														Type:     schema.TypeString,
 														Required: true,
+														ValidateFunc: validation.All(
+															validation.StringLenBetween(1, 128),
+															validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_ -]+$`), "must contain only alphanumeric characters, hyphens, underscores and spaces"),
+														),
 													},
 												},
 											},
@@ -101,16 +158,46 @@ func customActionSchema() *schema.Schema {
 					},
 				},
 				"action_name": {
-					Type:         schema.TypeString,
-					Required:     true,
-					ForceNew:     true,
-					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 128),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
+					),
 				},
 			},
 		},
 	}
 }
 
+// duplicateCustomActionNames returns the action names used by more than one of the specified custom actions.
+// Unknown action names are ignored.
+func duplicateCustomActionNames(tfList []interface{}) []string {
+	var duplicates []string
+	seen := make(map[string]int)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := tfMap["action_name"].(string)
+		if name == "" {
+			continue
+		}
+
+		if seen[name]++; seen[name] == 2 {
+			duplicates = append(duplicates, name)
+		}
+	}
+
+	slices.Sort(duplicates)
+
+	return duplicates
+}
+
 func expandCustomActions(l []interface{}) []*networkfirewall.CustomAction {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -5,9 +5,10 @@ package networkfirewall
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
@@ -45,11 +46,10 @@ func ResourceLoggingConfiguration() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"log_destination_config": {
-							// At most 2 configurations can exist,
-							// with 1 destination for FLOW logs and 1 for ALERT logs
+							// At most 1 destination can exist for each log type
 							Type:     schema.TypeSet,
 							Required: true,
-							MaxItems: 2,
+							MaxItems: len(networkfirewall.LogType_Values()),
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"log_destination": {
@@ -85,10 +85,8 @@ func resourceLoggingConfigurationCreate(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[DEBUG] Adding Logging Configuration to NetworkFirewall Firewall: %s", firewallArn)
 
-	loggingConfigs := expandLoggingConfiguration(d.Get(names.AttrLoggingConfiguration).([]interface{}))
-	// cumulatively add the configured "log_destination_config" in "logging_configuration"
-	err := putLoggingConfiguration(ctx, conn, firewallArn, loggingConfigs)
-	if err != nil {
+	logDestinationConfigs := expandLogDestinationConfigs(d.Get(names.AttrLoggingConfiguration).([]interface{}))
+	if err := updateLoggingConfiguration(ctx, conn, firewallArn, nil, logDestinationConfigs); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -136,24 +134,8 @@ func resourceLoggingConfigurationUpdate(ctx context.Context, d *schema.ResourceD
 	log.Printf("[DEBUG] Updating Logging Configuration for NetworkFirewall Firewall: %s", d.Id())
 
 	o, n := d.GetChange(names.AttrLoggingConfiguration)
-	// Remove destination configs one by one, if any
-	if oldConfig := o.([]interface{}); len(oldConfig) != 0 && oldConfig[0] != nil {
-		loggingConfig := expandLoggingConfigurationOnUpdate(oldConfig)
-		if loggingConfig != nil {
-			err := removeLoggingConfiguration(ctx, conn, d.Id(), loggingConfig)
-			if err != nil {
-				return sdkdiag.AppendFromErr(diags, err)
-			}
-		}
-	}
-	// Only send new LoggingConfiguration with content
-	if newConfig := n.([]interface{}); len(newConfig) != 0 && newConfig[0] != nil {
-		loggingConfigs := expandLoggingConfiguration(d.Get(names.AttrLoggingConfiguration).([]interface{}))
-		// cumulatively add the configured "log_destination_config" in "logging_configuration"
-		err := putLoggingConfiguration(ctx, conn, d.Id(), loggingConfigs)
-		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
+	if err := updateLoggingConfiguration(ctx, conn, d.Id(), expandLogDestinationConfigs(o.([]interface{})), expandLogDestinationConfigs(n.([]interface{}))); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	return append(diags, resourceLoggingConfigurationRead(ctx, d, meta)...)
@@ -175,8 +157,7 @@ func resourceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceD
 	}
 
 	if output != nil && output.LoggingConfiguration != nil {
-		err := removeLoggingConfiguration(ctx, conn, aws.StringValue(output.FirewallArn), output.LoggingConfiguration)
-		if err != nil {
+		if err := updateLoggingConfiguration(ctx, conn, aws.StringValue(output.FirewallArn), output.LoggingConfiguration.LogDestinationConfigs, nil); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -184,100 +165,89 @@ func resourceLoggingConfigurationDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
-func putLoggingConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, l []*networkfirewall.LoggingConfiguration) error {
-	var errs []error
-
-	for _, config := range l {
+// updateLoggingConfiguration changes a firewall's log destination configs from old to new.
+// Each UpdateLoggingConfiguration call can only add or remove a single log destination config,
+// or change the destination of a single config, so the change is made in as many calls as needed.
+func updateLoggingConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, old, new []*networkfirewall.LogDestinationConfig) error {
+	for _, configs := range loggingConfigurationUpdateSteps(old, new) {
 		input := &networkfirewall.UpdateLoggingConfigurationInput{
-			FirewallArn:          aws.String(arn),
-			LoggingConfiguration: config,
+			FirewallArn: aws.String(arn),
 		}
 
-		_, err := conn.UpdateLoggingConfigurationWithContext(ctx, input)
+		// Removing the last log destination config requires an empty logging configuration.
+		if len(configs) > 0 {
+			input.LoggingConfiguration = &networkfirewall.LoggingConfiguration{
+				LogDestinationConfigs: configs,
+			}
+		}
 
-		if err != nil {
-			errs = append(errs, fmt.Errorf("adding Logging Configuration to NetworkFirewall Firewall (%s): %w", arn, err))
+		if _, err := conn.UpdateLoggingConfigurationWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating Logging Configuration for NetworkFirewall Firewall (%s): %w", arn, err)
 		}
 	}
 
-	return errors.Join(errs...)
+	return nil
 }
 
-func removeLoggingConfiguration(ctx context.Context, conn *networkfirewall.NetworkFirewall, arn string, l *networkfirewall.LoggingConfiguration) error {
-	if l == nil {
-		return nil
+// loggingConfigurationUpdateSteps returns the successive log destination configs that change old into new,
+// one log destination config at a time. Destinations are changed in place when the destination type is unchanged,
+// other log destination configs are removed before new ones are added.
+func loggingConfigurationUpdateSteps(old, new []*networkfirewall.LogDestinationConfig) [][]*networkfirewall.LogDestinationConfig {
+	var steps [][]*networkfirewall.LogDestinationConfig
+
+	newByLogType := make(map[string]*networkfirewall.LogDestinationConfig, len(new))
+	for _, v := range new {
+		newByLogType[aws.StringValue(v.LogType)] = v
 	}
 
-	var errs []error
+	current := slices.Clone(old)
 
-	// Must delete destination configs one at a time
-	for i, config := range l.LogDestinationConfigs {
-		input := &networkfirewall.UpdateLoggingConfigurationInput{
-			FirewallArn: aws.String(arn),
-		}
+	// Change destinations in place.
+	for i, v := range current {
+		n, ok := newByLogType[aws.StringValue(v.LogType)]
 
-		if i == 0 && len(l.LogDestinationConfigs) == 2 {
-			loggingConfig := &networkfirewall.LoggingConfiguration{
-				LogDestinationConfigs: []*networkfirewall.LogDestinationConfig{config},
-			}
-			input.LoggingConfiguration = loggingConfig
+		if !ok || aws.StringValue(n.LogDestinationType) != aws.StringValue(v.LogDestinationType) {
+			continue
 		}
 
-		_, err := conn.UpdateLoggingConfigurationWithContext(ctx, input)
-
-		if err != nil {
-			errs = append(errs, fmt.Errorf("removing Logging Configuration LogDestinationConfig (%v) from NetworkFirewall Firewall: %s: %w", config, arn, err))
+		if maps.Equal(aws.StringValueMap(n.LogDestination), aws.StringValueMap(v.LogDestination)) {
+			continue
 		}
+
+		current = slices.Clone(current)
+		current[i] = n
+		steps = append(steps, current)
 	}
 
-	return errors.Join(errs...)
-}
+	// Remove log destination configs that are no longer needed or whose destination type changes.
+	for i := 0; i < len(current); {
+		n, ok := newByLogType[aws.StringValue(current[i].LogType)]
 
-func expandLoggingConfiguration(l []interface{}) []*networkfirewall.LoggingConfiguration {
-	if len(l) == 0 || l[0] == nil {
-		return nil
-	}
-	tfMap, ok := l[0].(map[string]interface{})
-	if !ok {
-		return nil
+		if ok && aws.StringValue(n.LogDestinationType) == aws.StringValue(current[i].LogDestinationType) {
+			i++
+			continue
+		}
+
+		current = slices.Delete(slices.Clone(current), i, i+1)
+		steps = append(steps, current)
 	}
 
-	loggingConfigs := make([]*networkfirewall.LoggingConfiguration, 0)
-	if tfSet, ok := tfMap["log_destination_config"].(*schema.Set); ok && tfSet.Len() > 0 {
-		tfList := tfSet.List()
-		for _, tfMapRaw := range tfList {
-			tfMap, ok := tfMapRaw.(map[string]interface{})
-			if !ok {
-				continue
-			}
-			config := &networkfirewall.LogDestinationConfig{}
-			if v, ok := tfMap["log_destination"].(map[string]interface{}); ok && len(v) > 0 {
-				config.LogDestination = aws.StringMap(expandLogDestinationConfigLogDestination(v))
-			}
-			if v, ok := tfMap["log_destination_type"].(string); ok && v != "" {
-				config.LogDestinationType = aws.String(v)
-			}
-			if v, ok := tfMap["log_type"].(string); ok && v != "" {
-				config.LogType = aws.String(v)
-			}
-			// exclude empty LogDestinationConfig due to TypeMap in TypeSet behavior
-			// Related: https://github.com/hashicorp/terraform-plugin-sdk/issues/588
-			if config.LogDestination == nil && config.LogDestinationType == nil && config.LogType == nil {
-				continue
-			}
-			loggingConfig := &networkfirewall.LoggingConfiguration{}
-			// include all (max 2) "log_destination_config" i.e. prepend the already-expanded loggingConfig
-			if len(loggingConfigs) == 1 && len(loggingConfigs[0].LogDestinationConfigs) == 1 {
-				loggingConfig.LogDestinationConfigs = append(loggingConfig.LogDestinationConfigs, loggingConfigs[0].LogDestinationConfigs[0])
-			}
-			loggingConfig.LogDestinationConfigs = append(loggingConfig.LogDestinationConfigs, config)
-			loggingConfigs = append(loggingConfigs, loggingConfig)
+	// Add the new log destination configs.
+	for _, v := range new {
+		if slices.ContainsFunc(current, func(c *networkfirewall.LogDestinationConfig) bool {
+			return aws.StringValue(c.LogType) == aws.StringValue(v.LogType)
+		}) {
+			continue
 		}
+
+		current = append(slices.Clone(current), v)
+		steps = append(steps, current)
 	}
-	return loggingConfigs
+
+	return steps
 }
 
-func expandLoggingConfigurationOnUpdate(l []interface{}) *networkfirewall.LoggingConfiguration {
+func expandLogDestinationConfigs(l []interface{}) []*networkfirewall.LogDestinationConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
 	}
@@ -286,11 +256,9 @@ func expandLoggingConfigurationOnUpdate(l []interface{}) *networkfirewall.Loggin
 		return nil
 	}
 
-	loggingConfig := &networkfirewall.LoggingConfiguration{}
+	var configs []*networkfirewall.LogDestinationConfig
 	if tfSet, ok := tfMap["log_destination_config"].(*schema.Set); ok && tfSet.Len() > 0 {
-		tfList := tfSet.List()
-		destConfigs := make([]*networkfirewall.LogDestinationConfig, 0, len(tfList))
-		for _, tfMapRaw := range tfList {
+		for _, tfMapRaw := range tfSet.List() {
 			tfMap, ok := tfMapRaw.(map[string]interface{})
 			if !ok {
 				continue
@@ -310,11 +278,10 @@ func expandLoggingConfigurationOnUpdate(l []interface{}) *networkfirewall.Loggin
 			if config.LogDestination == nil && config.LogDestinationType == nil && config.LogType == nil {
 				continue
 			}
-			destConfigs = append(destConfigs, config)
+			configs = append(configs, config)
 		}
-		loggingConfig.LogDestinationConfigs = destConfigs
 	}
-	return loggingConfig
+	return configs
 }
 
 func expandLogDestinationConfigLogDestination(dst map[string]interface{}) map[string]string {
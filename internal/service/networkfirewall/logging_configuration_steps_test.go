@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoggingConfigurationUpdateSteps(t *testing.T) {
+	t.Parallel()
+
+	config := func(logType, logDestinationType, destination string) *networkfirewall.LogDestinationConfig {
+		return &networkfirewall.LogDestinationConfig{
+			LogDestination:     aws.StringMap(map[string]string{"destination": destination}),
+			LogDestinationType: aws.String(logDestinationType),
+			LogType:            aws.String(logType),
+		}
+	}
+	summarize := func(steps [][]*networkfirewall.LogDestinationConfig) [][]string {
+		var output [][]string
+		for _, step := range steps {
+			s := []string{}
+			for _, v := range step {
+				s = append(s, aws.StringValue(v.LogType)+":"+aws.StringValue(v.LogDestinationType)+":"+aws.StringValue(v.LogDestination["destination"]))
+			}
+			output = append(output, s)
+		}
+		return output
+	}
+
+	alertS3 := config(networkfirewall.LogTypeAlert, networkfirewall.LogDestinationTypeS3, "a")
+	alertS3Changed := config(networkfirewall.LogTypeAlert, networkfirewall.LogDestinationTypeS3, "b")
+	alertCloudWatch := config(networkfirewall.LogTypeAlert, networkfirewall.LogDestinationTypeCloudWatchLogs, "c")
+	flowFirehose := config(networkfirewall.LogTypeFlow, networkfirewall.LogDestinationTypeKinesisDataFirehose, "d")
+
+	testCases := map[string]struct {
+		old, new []*networkfirewall.LogDestinationConfig
+		expected [][]string
+	}{
+		"no change": {
+			old: []*networkfirewall.LogDestinationConfig{alertS3, flowFirehose},
+			new: []*networkfirewall.LogDestinationConfig{flowFirehose, alertS3},
+		},
+		"create": {
+			new: []*networkfirewall.LogDestinationConfig{alertS3, flowFirehose},
+			expected: [][]string{
+				{"ALERT:S3:a"},
+				{"ALERT:S3:a", "FLOW:KinesisDataFirehose:d"},
+			},
+		},
+		"delete": {
+			old: []*networkfirewall.LogDestinationConfig{alertS3, flowFirehose},
+			expected: [][]string{
+				{"FLOW:KinesisDataFirehose:d"},
+				{},
+			},
+		},
+		"change destination": {
+			old: []*networkfirewall.LogDestinationConfig{alertS3, flowFirehose},
+			new: []*networkfirewall.LogDestinationConfig{alertS3Changed, flowFirehose},
+			expected: [][]string{
+				{"ALERT:S3:b", "FLOW:KinesisDataFirehose:d"},
+			},
+		},
+		"change destination type": {
+			old: []*networkfirewall.LogDestinationConfig{alertS3, flowFirehose},
+			new: []*networkfirewall.LogDestinationConfig{alertCloudWatch, flowFirehose},
+			expected: [][]string{
+				{"FLOW:KinesisDataFirehose:d"},
+				{"FLOW:KinesisDataFirehose:d", "ALERT:CloudWatchLogs:c"},
+			},
+		},
+		"replace log type": {
+			old: []*networkfirewall.LogDestinationConfig{alertS3},
+			new: []*networkfirewall.LogDestinationConfig{flowFirehose},
+			expected: [][]string{
+				{},
+				{"FLOW:KinesisDataFirehose:d"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := summarize(loggingConfigurationUpdateSteps(testCase.old, testCase.new))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,434 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/firehose"
+	firehosetypes "github.com/aws/aws-sdk-go-v2/service/firehose/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// logDeliveryServicePrincipal is the service principal that delivers Network Firewall logs to S3 and Kinesis Data Firehose.
+	logDeliveryServicePrincipal = "delivery.logs.amazonaws.com"
+	// logDeliveryEnabledTagKey is the tag that must be set on Kinesis Data Firehose delivery streams that receive Network Firewall logs.
+	logDeliveryEnabledTagKey = "LogDeliveryEnabled"
+)
+
+// @SDKDataSource("aws_networkfirewall_logging_destination")
+func DataSourceLoggingDestination() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLoggingDestinationRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"bucket_name", "delivery_stream_name"},
+			},
+			"delivery_stream_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"bucket_name", "delivery_stream_name"},
+			},
+			"firewall_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"log_destination": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"log_destination_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrPrefix: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"delivery_stream_name"},
+			},
+		},
+	}
+}
+
+func dataSourceLoggingDestinationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+
+	accountID := d.Get("firewall_account_id").(string)
+	var problems []string
+	var logDestination map[string]string
+	var logDestinationType, destination string
+
+	if v, ok := d.GetOk("bucket_name"); ok {
+		bucket, prefix := v.(string), d.Get(names.AttrPrefix).(string)
+		destination = bucket
+
+		var err error
+		problems, err = checkS3LoggingDestination(ctx, client.S3Client(ctx), client.KMSClient(ctx), client.Partition, client.Region, bucket, prefix, accountID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Logging Destination (%s): %s", bucket, err)
+		}
+
+		logDestination = map[string]string{
+			"bucketName": bucket,
+		}
+		if prefix != "" {
+			logDestination["prefix"] = prefix
+		}
+		logDestinationType = networkfirewall.LogDestinationTypeS3
+	} else {
+		name := d.Get("delivery_stream_name").(string)
+		destination = name
+
+		var err error
+		problems, err = checkFirehoseLoggingDestination(ctx, client.FirehoseClient(ctx), name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Logging Destination (%s): %s", name, err)
+		}
+
+		logDestination = map[string]string{
+			"deliveryStream": name,
+		}
+		logDestinationType = networkfirewall.LogDestinationTypeKinesisDataFirehose
+	}
+
+	for _, problem := range problems {
+		diags = sdkdiag.AppendErrorf(diags, "NetworkFirewall Logging Destination (%s) can't receive logs from account %s: %s", destination, accountID, problem)
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, destination))
+	d.Set("log_destination", logDestination)
+	d.Set("log_destination_type", logDestinationType)
+
+	return diags
+}
+
+// checkS3LoggingDestination returns the reasons why the specified bucket can't receive logs from firewalls in the specified account.
+func checkS3LoggingDestination(ctx context.Context, s3Conn *s3.Client, kmsConn *kms.Client, partition, region, bucket, prefix, accountID string) ([]string, error) {
+	var policy string
+	output, err := s3Conn.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{
+		Bucket: aws.String(bucket),
+	})
+
+	switch {
+	case tfawserr.ErrCodeEquals(err, "NoSuchBucketPolicy"):
+	case err != nil:
+		return nil, fmt.Errorf("reading S3 Bucket (%s) policy: %w", bucket, err)
+	default:
+		policy = aws.ToString(output.Policy)
+	}
+
+	problems, err := checkS3LoggingDestinationPolicy(policy, partition, region, bucket, prefix, accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing S3 Bucket (%s) policy: %w", bucket, err)
+	}
+
+	keyID, err := findBucketLoggingKMSKeyID(ctx, s3Conn, bucket)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if keyID == "" {
+		return problems, nil
+	}
+
+	key, err := kmsConn.DescribeKey(ctx, &kms.DescribeKeyInput{
+		KeyId: aws.String(keyID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Key (%s): %w", keyID, err)
+	}
+
+	// The key policies of AWS managed keys can't be changed to allow log delivery.
+	if key.KeyMetadata.KeyManager == kmstypes.KeyManagerTypeAws {
+		return append(problems, fmt.Sprintf("bucket is encrypted with AWS managed KMS key %s, use a customer managed key", keyID)), nil
+	}
+
+	keyPolicy, err := kmsConn.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{
+		KeyId:      key.KeyMetadata.KeyId,
+		PolicyName: aws.String("default"),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading KMS Key (%s) policy: %w", keyID, err)
+	}
+
+	keyProblems, err := checkKMSLoggingDestinationPolicy(aws.ToString(keyPolicy.Policy), partition, region, accountID)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing KMS Key (%s) policy: %w", keyID, err)
+	}
+
+	return append(problems, keyProblems...), nil
+}
+
+// findBucketLoggingKMSKeyID returns the ID of the KMS key that the specified bucket encrypts new objects with by default, if any.
+func findBucketLoggingKMSKeyID(ctx context.Context, conn *s3.Client, bucket string) (string, error) {
+	output, err := conn.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{
+		Bucket: aws.String(bucket),
+	})
+
+	if tfawserr.ErrCodeEquals(err, "ServerSideEncryptionConfigurationNotFoundError") {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("reading S3 Bucket (%s) encryption configuration: %w", bucket, err)
+	}
+
+	if output.ServerSideEncryptionConfiguration == nil {
+		return "", nil
+	}
+
+	for _, rule := range output.ServerSideEncryptionConfiguration.Rules {
+		v := rule.ApplyServerSideEncryptionByDefault
+		if v == nil {
+			continue
+		}
+
+		switch v.SSEAlgorithm {
+		case s3types.ServerSideEncryptionAwsKms, s3types.ServerSideEncryptionAwsKmsDsse:
+			if keyID := aws.ToString(v.KMSMasterKeyID); keyID != "" {
+				return keyID, nil
+			}
+
+			return "alias/aws/s3", nil
+		}
+	}
+
+	return "", nil
+}
+
+// checkFirehoseLoggingDestination returns the reasons why the specified delivery stream can't receive logs.
+func checkFirehoseLoggingDestination(ctx context.Context, conn *firehose.Client, name string) ([]string, error) {
+	output, err := conn.DescribeDeliveryStream(ctx, &firehose.DescribeDeliveryStreamInput{
+		DeliveryStreamName: aws.String(name),
+	})
+
+	if errs.IsA[*firehosetypes.ResourceNotFoundException](err) {
+		return []string{"delivery stream not found"}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Kinesis Firehose Delivery Stream (%s): %w", name, err)
+	}
+
+	var problems []string
+
+	if status := output.DeliveryStreamDescription.DeliveryStreamStatus; status != firehosetypes.DeliveryStreamStatusActive {
+		problems = append(problems, fmt.Sprintf("delivery stream status is %s", status))
+	}
+
+	input := &firehose.ListTagsForDeliveryStreamInput{
+		DeliveryStreamName: aws.String(name),
+	}
+	var tagged bool
+
+	for {
+		output, err := conn.ListTagsForDeliveryStream(ctx, input)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing tags for Kinesis Firehose Delivery Stream (%s): %w", name, err)
+		}
+
+		for _, tag := range output.Tags {
+			if aws.ToString(tag.Key) == logDeliveryEnabledTagKey && strings.EqualFold(aws.ToString(tag.Value), "true") {
+				tagged = true
+			}
+		}
+
+		if !aws.ToBool(output.HasMoreTags) || len(output.Tags) == 0 {
+			break
+		}
+
+		input.ExclusiveStartTagKey = output.Tags[len(output.Tags)-1].Key
+	}
+
+	if !tagged {
+		problems = append(problems, fmt.Sprintf("delivery stream isn't tagged with %s = true", logDeliveryEnabledTagKey))
+	}
+
+	return problems, nil
+}
+
+// checkS3LoggingDestinationPolicy returns the log delivery permissions that the specified bucket policy is missing.
+func checkS3LoggingDestinationPolicy(policy, partition, region, bucket, prefix, accountID string) ([]string, error) {
+	doc, err := parseLoggingDestinationPolicy(policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	bucketARN := fmt.Sprintf("arn:%s:s3:::%s", partition, bucket)
+	objectKey := fmt.Sprintf("AWSLogs/%s/*", accountID)
+	if prefix != "" {
+		objectKey = prefix + "/" + objectKey
+	}
+	objectARN := bucketARN + "/" + objectKey
+
+	var problems []string
+
+	if !logDeliveryAllowed(doc, "s3:GetBucketAcl", bucketARN, partition, region, accountID) {
+		problems = append(problems, fmt.Sprintf("bucket policy doesn't allow %s to perform s3:GetBucketAcl on %s", logDeliveryServicePrincipal, bucketARN))
+	}
+
+	if !logDeliveryAllowed(doc, "s3:PutObject", objectARN, partition, region, accountID) {
+		problems = append(problems, fmt.Sprintf("bucket policy doesn't allow %s to perform s3:PutObject on %s", logDeliveryServicePrincipal, objectARN))
+	}
+
+	return problems, nil
+}
+
+// checkKMSLoggingDestinationPolicy returns the log delivery permissions that the specified KMS key policy is missing.
+func checkKMSLoggingDestinationPolicy(policy, partition, region, accountID string) ([]string, error) {
+	doc, err := parseLoggingDestinationPolicy(policy)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !logDeliveryAllowed(doc, "kms:GenerateDataKey", "*", partition, region, accountID) {
+		return []string{fmt.Sprintf("KMS key policy doesn't allow %s to perform kms:GenerateDataKey", logDeliveryServicePrincipal)}, nil
+	}
+
+	return nil, nil
+}
+
+func parseLoggingDestinationPolicy(policy string) (*tfiam.IAMPolicyDoc, error) {
+	doc := &tfiam.IAMPolicyDoc{}
+
+	if policy == "" {
+		return doc, nil
+	}
+
+	if err := json.Unmarshal([]byte(policy), doc); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// logDeliveryAllowed returns whether an Allow statement in the specified policy grants the log delivery service
+// the specified action on the specified resource for logs from the specified account.
+// Deny statements and conditions other than aws:SourceAccount and aws:SourceArn aren't evaluated.
+func logDeliveryAllowed(doc *tfiam.IAMPolicyDoc, action, resource, partition, region, accountID string) bool {
+	sourceARN := fmt.Sprintf("arn:%s:logs:%s:%s:*", partition, region, accountID)
+
+	for _, statement := range doc.Statements {
+		if statement.Effect != "Allow" {
+			continue
+		}
+
+		if !policyStatementHasLogDeliveryPrincipal(statement) {
+			continue
+		}
+
+		if !policyValuesMatch(statement.Actions, action, true) {
+			continue
+		}
+
+		if statement.Resources != nil && !policyValuesMatch(statement.Resources, resource, false) {
+			continue
+		}
+
+		allowed := true
+		for _, condition := range statement.Conditions {
+			switch strings.ToLower(condition.Variable) {
+			case "aws:sourceaccount":
+				allowed = allowed && policyValuesMatch(condition.Values, accountID, false)
+			case "aws:sourcearn":
+				allowed = allowed && policyValuesMatch(condition.Values, sourceARN, false)
+			}
+		}
+
+		if allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func policyStatementHasLogDeliveryPrincipal(statement *tfiam.IAMPolicyStatement) bool {
+	for _, principal := range statement.Principals {
+		switch principal.Type {
+		case "*":
+			return true
+		case "AWS":
+			if policyValuesMatch(principal.Identifiers, "*", false) {
+				return true
+			}
+		case "Service":
+			if policyValuesMatch(principal.Identifiers, logDeliveryServicePrincipal, false) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// policyValuesMatch returns whether any of the specified policy values, which may contain wildcards, matches v.
+func policyValuesMatch(values interface{}, v string, caseInsensitive bool) bool {
+	var patterns []string
+
+	switch values := values.(type) {
+	case string:
+		patterns = append(patterns, values)
+	case []string:
+		patterns = append(patterns, values...)
+	case []interface{}:
+		for _, value := range values {
+			if value, ok := value.(string); ok {
+				patterns = append(patterns, value)
+			}
+		}
+	}
+
+	for _, pattern := range patterns {
+		expr := strings.NewReplacer(`\*`, `.*`, `\?`, `.`).Replace(regexp.QuoteMeta(pattern))
+		if caseInsensitive {
+			expr = "(?i)" + expr
+		}
+
+		if regexp.MustCompile("^" + expr + "$").MatchString(v) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCheckS3LoggingDestinationPolicy(t *testing.T) {
+	t.Parallel()
+
+	const (
+		getBucketACL = `{
+  "Sid": "AWSLogDeliveryAclCheck",
+  "Effect": "Allow",
+  "Principal": {"Service": "delivery.logs.amazonaws.com"},
+  "Action": "s3:GetBucketAcl",
+  "Resource": "arn:aws:s3:::logs"
+}`
+		getBucketACLMissing = "bucket policy doesn't allow delivery.logs.amazonaws.com to perform s3:GetBucketAcl on arn:aws:s3:::logs"
+		putObjectMissing    = "bucket policy doesn't allow delivery.logs.amazonaws.com to perform s3:PutObject on arn:aws:s3:::logs/firewall/AWSLogs/111111111111/*"
+	)
+
+	testCases := map[string]struct {
+		policy   string
+		expected []string
+	}{
+		"no policy": {
+			expected: []string{getBucketACLMissing, putObjectMissing},
+		},
+		"valid": {
+			policy: `{"Version": "2012-10-17", "Statement": [` + getBucketACL + `, {
+  "Effect": "Allow",
+  "Principal": {"Service": ["delivery.logs.amazonaws.com"]},
+  "Action": ["s3:PutObject"],
+  "Resource": "arn:aws:s3:::logs/firewall/AWSLogs/111111111111/*",
+  "Condition": {"StringEquals": {"aws:SourceAccount": ["111111111111", "222222222222"], "s3:x-amz-acl": "bucket-owner-full-control"}}
+}]}`,
+		},
+		"wildcards": {
+			policy: `{"Version": "2012-10-17", "Statement": [{
+  "Effect": "Allow",
+  "Principal": {"Service": "delivery.logs.amazonaws.com"},
+  "Action": ["S3:Get*", "s3:Put*"],
+  "Resource": ["arn:aws:s3:::logs", "arn:aws:s3:::logs/*"],
+  "Condition": {"ArnLike": {"aws:SourceArn": "arn:aws:logs:*:111111111111:*"}}
+}]}`,
+		},
+		"other account": {
+			policy: `{"Version": "2012-10-17", "Statement": [` + getBucketACL + `, {
+  "Effect": "Allow",
+  "Principal": {"Service": "delivery.logs.amazonaws.com"},
+  "Action": "s3:PutObject",
+  "Resource": "arn:aws:s3:::logs/firewall/AWSLogs/*",
+  "Condition": {"StringEquals": {"aws:SourceAccount": "222222222222"}}
+}]}`,
+			expected: []string{putObjectMissing},
+		},
+		"other prefix": {
+			policy: `{"Version": "2012-10-17", "Statement": [` + getBucketACL + `, {
+  "Effect": "Allow",
+  "Principal": {"Service": "delivery.logs.amazonaws.com"},
+  "Action": "s3:PutObject",
+  "Resource": "arn:aws:s3:::logs/alb/AWSLogs/111111111111/*"
+}]}`,
+			expected: []string{putObjectMissing},
+		},
+		"other principal": {
+			policy: `{"Version": "2012-10-17", "Statement": [` + getBucketACL + `, {
+  "Effect": "Allow",
+  "Principal": {"AWS": "arn:aws:iam::111111111111:root"},
+  "Action": "s3:PutObject",
+  "Resource": "arn:aws:s3:::logs/*"
+}]}`,
+			expected: []string{putObjectMissing},
+		},
+		"deny": {
+			policy: `{"Version": "2012-10-17", "Statement": [{
+  "Effect": "Deny",
+  "Principal": "*",
+  "Action": "s3:*",
+  "Resource": ["arn:aws:s3:::logs", "arn:aws:s3:::logs/*"]
+}]}`,
+			expected: []string{getBucketACLMissing, putObjectMissing},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := checkS3LoggingDestinationPolicy(testCase.policy, "aws", "us-west-2", "logs", "firewall", "111111111111") //lintignore:AWSAT003,AWSAT005
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -36,7 +36,12 @@ func ResourceRuleGroup() *schema.Resource {
 		DeleteWithoutTimeout: resourceRuleGroupDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("detect_external_changes", false)
+				d.Set("mode", ruleGroupModeEnforced)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,11 +54,26 @@ func ResourceRuleGroup() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"consumed_capacity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"detect_external_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrEncryptionConfiguration: encryptionConfigurationSchema(),
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ruleGroupModeEnforced,
+				ValidateFunc: validation.StringInSlice(ruleGroupMode_Values(), false),
+			},
 			names.AttrName: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,9 +152,37 @@ func ResourceRuleGroup() *schema.Resource {
 													},
 												},
 												"targets": {
-													Type:     schema.TypeSet,
-													Required: true,
-													Elem:     &schema.Schema{Type: schema.TypeString},
+													Type:         schema.TypeSet,
+													Optional:     true,
+													ExactlyOneOf: []string{"rule_group.0.rules_source.0.rules_source_list.0.targets", "rule_group.0.rules_source.0.rules_source_list.0.targets_source"},
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validDomainListTarget,
+													},
+												},
+												"targets_source": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"s3_bucket": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																RequiredWith: []string{"rule_group.0.rules_source.0.rules_source_list.0.targets_source.0.s3_key"},
+																ExactlyOneOf: []string{"rule_group.0.rules_source.0.rules_source_list.0.targets_source.0.s3_bucket", "rule_group.0.rules_source.0.rules_source_list.0.targets_source.0.ssm_parameter_name"},
+															},
+															"s3_key": {
+																Type:         schema.TypeString,
+																Optional:     true,
+																RequiredWith: []string{"rule_group.0.rules_source.0.rules_source_list.0.targets_source.0.s3_bucket"},
+															},
+															"ssm_parameter_name": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
 												},
 											},
 										},
@@ -432,8 +480,31 @@ func ResourceRuleGroup() *schema.Resource {
 				},
 			},
 			"rules": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rules_s3_source"},
+			},
+			"rules_s3_source": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rules"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -454,6 +525,9 @@ func ResourceRuleGroup() *schema.Resource {
 			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 				return forceNewIfNotRuleOrderDefault("rule_group.0.stateful_rule_options.0.rule_order", d)
 			},
+			customizeDiffRuleGroupRulesSourceList,
+			customizeDiffRuleGroupRulesS3Source,
+			customizeDiffRuleGroupMode,
 			verify.SetTagsDiff,
 		),
 	}
@@ -478,14 +552,18 @@ func resourceRuleGroupCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	if v, ok := d.GetOk(names.AttrEncryptionConfiguration); ok {
 		input.EncryptionConfiguration = expandEncryptionConfiguration(v.([]interface{}))
+
+		if diags = append(diags, checkEncryptionConfigurationKey(ctx, meta.(*conns.AWSClient).KMSClient(ctx), input.EncryptionConfiguration)...); diags.HasError() {
+			return diags
+		}
 	}
 
 	if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.RuleGroup = expandRuleGroup(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("rules"); ok {
-		input.Rules = aws.String(v.(string))
+	if _, ok := d.GetOk("rules"); ok {
+		input.Rules = aws.String(ruleGroupRules(d))
 	}
 
 	output, err := conn.CreateRuleGroupWithContext(ctx, input)
@@ -520,13 +598,28 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Rule Group (%s): %s", d.Id(), err)
 	}
 
+	// The update token changes on every modification of the rule group, including ones
+	// that don't alter the normalized rules, so a mismatch with the token recorded in state
+	// means the rule group was changed outside of Terraform.
+	if old, new := d.Get("update_token").(string), aws.StringValue(output.UpdateToken); d.Get("detect_external_changes").(bool) && !d.IsNewResource() && old != "" && old != new {
+		diags = sdkdiag.AppendWarningf(diags, "NetworkFirewall Rule Group (%s) was modified outside of Terraform (update token %s, expected %s)", d.Id(), new, old)
+	}
+
 	response := output.RuleGroupResponse
 	d.Set(names.AttrARN, response.RuleGroupArn)
 	d.Set("capacity", response.Capacity)
+	d.Set("consumed_capacity", response.ConsumedCapacity)
 	d.Set(names.AttrDescription, response.Description)
 	d.Set(names.AttrEncryptionConfiguration, flattenEncryptionConfiguration(response.EncryptionConfiguration))
 	d.Set(names.AttrName, response.RuleGroupName)
-	if err := d.Set("rule_group", flattenRuleGroup(output.RuleGroup)); err != nil {
+	ruleGroup := flattenRuleGroup(output.RuleGroup)
+	// The source of a domain list's targets isn't returned by the API.
+	if v := tfMapAtPath(d.Get("rule_group").([]interface{}), "rules_source", "rules_source_list"); v != nil {
+		if tfMap := tfMapAtPath(ruleGroup, "rules_source", "rules_source_list"); tfMap != nil {
+			tfMap["targets_source"] = v["targets_source"]
+		}
+	}
+	if err := d.Set("rule_group", ruleGroup); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule_group: %s", err)
 	}
 	d.Set(names.AttrType, response.Type)
@@ -542,7 +635,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
 
-	if d.HasChanges(names.AttrDescription, names.AttrEncryptionConfiguration, "rule_group", "rules", names.AttrType) {
+	if d.HasChanges(names.AttrDescription, names.AttrEncryptionConfiguration, "mode", "rule_group", "rules", names.AttrType) {
 		input := &networkfirewall.UpdateRuleGroupInput{
 			EncryptionConfiguration: expandEncryptionConfiguration(d.Get(names.AttrEncryptionConfiguration).([]interface{})),
 			RuleGroupArn:            aws.String(d.Id()),
@@ -558,7 +651,10 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		// else, request returns "InvalidRequestException: Exactly one of Rules or RuleGroup must be set";
 		// Here, "rules" takes precedence as "rule_group" is Computed from "rules" when configured
 		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19414
-		if d.HasChange("rules") {
+		// A change of mode rewrites the actions of the configured rules.
+		if _, ok := d.GetOk("rules"); ok && d.HasChanges("mode", "rules") {
+			input.Rules = aws.String(ruleGroupRules(d))
+		} else if d.HasChange("rules") {
 			input.Rules = aws.String(d.Get("rules").(string))
 		} else if d.HasChange("rule_group") {
 			if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
@@ -570,18 +666,33 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 		// at least one must still be sent to allow other attributes (ex. description) to update.
 		// Give precedence again to "rules", as documented above.
 		if input.Rules == nil && input.RuleGroup == nil {
-			if v, ok := d.GetOk("rules"); ok {
-				input.Rules = aws.String(v.(string))
+			if _, ok := d.GetOk("rules"); ok {
+				input.Rules = aws.String(ruleGroupRules(d))
 			} else if v, ok := d.GetOk("rule_group"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 				input.RuleGroup = expandRuleGroup(v.([]interface{})[0].(map[string]interface{}))
 			}
 		}
 
-		_, err := conn.UpdateRuleGroupWithContext(ctx, input)
+		if d.HasChange(names.AttrEncryptionConfiguration) {
+			if diags = append(diags, checkEncryptionConfigurationKey(ctx, meta.(*conns.AWSClient).KMSClient(ctx), input.EncryptionConfiguration)...); diags.HasError() {
+				return diags
+			}
+		}
+
+		output, err := conn.UpdateRuleGroupWithContext(ctx, input)
+
+		// The update token changes whenever the rule group is changed, e.g. outside of Terraform.
+		// Don't overwrite such changes by retrying with the current token.
+		if tfawserr.ErrCodeEquals(err, networkfirewall.ErrCodeInvalidTokenException) {
+			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Rule Group (%s): the rule group was changed since it was last read, refresh and plan again to review the changes: %s", d.Id(), err)
+		}
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Rule Group (%s): %s", d.Id(), err)
 		}
+
+		// Record the token issued for this update so that it isn't reported as an external change.
+		d.Set("update_token", output.UpdateToken)
 	}
 
 	return append(diags, resourceRuleGroupRead(ctx, d, meta)...)
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	// Maximum number of domains in a domain list rule group.
+	// Each domain requires at least one unit of rule group capacity.
+	domainListTargetsMax = 30000
+	// Maximum length of a domain name.
+	domainListTargetLenMax = 253
+)
+
+var (
+	domainListTargetLabelRegexp = regexache.MustCompile(`^[0-9A-Za-z]([0-9A-Za-z-]{0,61}[0-9A-Za-z])?$`)
+)
+
+// validDomainListTarget validates a domain list target.
+// A target is either an explicit domain name, e.g. "www.example.com", or a domain name with a leading "." that
+// matches the domain and all of its subdomains, e.g. ".example.com". No other wildcard syntax is supported.
+func validDomainListTarget(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := validateDomainListTarget(value); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return
+}
+
+func validateDomainListTarget(target string) error {
+	if strings.Contains(target, "*") {
+		return fmt.Errorf("%q: wildcards aren't supported, use a leading \".\" to match all subdomains, e.g. \".example.com\"", target)
+	}
+
+	name := strings.TrimPrefix(target, ".")
+
+	if name == "" {
+		return fmt.Errorf("%q: domain name must not be empty", target)
+	}
+
+	if len(name) > domainListTargetLenMax {
+		return fmt.Errorf("%q: domain name must be at most %d characters", target, domainListTargetLenMax)
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if !domainListTargetLabelRegexp.MatchString(label) {
+			return fmt.Errorf("%q: %q must be 1-63 alphanumeric characters or hyphens and must not begin or end with a hyphen", target, label)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffRuleGroupRulesSourceList resolves the domains of a rules_source_list's targets_source
+// and validates the domain list against the rule group's capacity.
+func customizeDiffRuleGroupRulesSourceList(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const (
+		key = "rule_group.0.rules_source.0.rules_source_list.0"
+	)
+	if !d.NewValueKnown(key + ".targets_source") {
+		return nil
+	}
+
+	tfList, ok := d.Get("rule_group").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	tfMap := tfMapAtPath(tfList, "rules_source", "rules_source_list")
+	if tfMap == nil {
+		return nil
+	}
+
+	var targets *schema.Set
+	var sourced bool
+
+	if v, ok := tfMap["targets_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		domains, err := findDomainListTargets(ctx, meta.(*conns.AWSClient), v[0].(map[string]interface{}))
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range domains {
+			if err := validateDomainListTarget(v); err != nil {
+				return fmt.Errorf("%s.targets_source: %w", key, err)
+			}
+		}
+
+		targets = schema.NewSet(schema.HashString, flex.FlattenStringValueList(domains))
+		sourced = true
+	} else if v, ok := tfMap["targets"].(*schema.Set); ok && d.NewValueKnown(key+".targets") {
+		targets = v
+	}
+
+	if targets == nil {
+		return nil
+	}
+
+	if n := targets.Len(); n > domainListTargetsMax {
+		return fmt.Errorf("%s: domain list has %d targets, the maximum is %d", key, n, domainListTargetsMax)
+	}
+
+	if d.NewValueKnown("capacity") {
+		if n, capacity := targets.Len(), d.Get("capacity").(int); n > capacity {
+			return fmt.Errorf("%s: domain list has %d targets, which exceeds the rule group capacity of %d", key, n, capacity)
+		}
+	}
+
+	if !sourced {
+		return nil
+	}
+
+	tfMap["targets"] = targets
+
+	return d.SetNew("rule_group", tfList)
+}
+
+// tfMapAtPath returns the map at the specified path of single-element nested blocks, or nil if any block is missing.
+func tfMapAtPath(tfList []interface{}, path ...string) map[string]interface{} {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	for _, k := range path {
+		v, ok := tfMap[k].([]interface{})
+		if !ok || len(v) == 0 || v[0] == nil {
+			return nil
+		}
+
+		tfMap = v[0].(map[string]interface{})
+	}
+
+	return tfMap
+}
+
+// findDomainListTargets returns the domains in an S3 object or SSM parameter.
+// Domains are separated by newlines or commas. Blank lines and lines beginning with "#" are ignored.
+func findDomainListTargets(ctx context.Context, client *conns.AWSClient, tfMap map[string]interface{}) ([]string, error) {
+	var content string
+
+	if v, ok := tfMap["ssm_parameter_name"].(string); ok && v != "" {
+		output, err := client.SSMConn(ctx).GetParameterWithContext(ctx, &ssm.GetParameterInput{
+			Name:           aws.String(v),
+			WithDecryption: aws.Bool(true),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading SSM Parameter (%s): %w", v, err)
+		}
+
+		if output.Parameter != nil {
+			content = aws.StringValue(output.Parameter.Value)
+		}
+	} else if bucket, key := tfMap["s3_bucket"].(string), tfMap["s3_key"].(string); bucket != "" && key != "" {
+		output, err := client.S3Client(ctx).GetObject(ctx, &s3.GetObjectInput{
+			Bucket: aws_sdkv2.String(bucket),
+			Key:    aws_sdkv2.String(key),
+		})
+
+		if err != nil {
+			return nil, fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+		}
+		defer output.Body.Close()
+
+		b, err := io.ReadAll(output.Body)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+		}
+
+		content = string(b)
+	}
+
+	return parseDomainListTargets(content), nil
+}
+
+func parseDomainListTargets(content string) []string {
+	var targets []string
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		for _, v := range strings.Split(line, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				targets = append(targets, v)
+			}
+		}
+	}
+
+	return targets
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateDomainListTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		target    string
+		expectErr bool
+	}{
+		"explicit domain": {
+			target: "www.example.com",
+		},
+		"subdomain wildcard": {
+			target: ".example.com",
+		},
+		"single label": {
+			target: "localhost",
+		},
+		"hyphenated label": {
+			target: "my-site.example.com",
+		},
+		"empty": {
+			target:    "",
+			expectErr: true,
+		},
+		"dot only": {
+			target:    ".",
+			expectErr: true,
+		},
+		"asterisk wildcard": {
+			target:    "*.example.com",
+			expectErr: true,
+		},
+		"embedded wildcard": {
+			target:    "www.*.example.com",
+			expectErr: true,
+		},
+		"empty label": {
+			target:    "www..example.com",
+			expectErr: true,
+		},
+		"trailing dot": {
+			target:    "example.com.",
+			expectErr: true,
+		},
+		"leading hyphen": {
+			target:    "-www.example.com",
+			expectErr: true,
+		},
+		"invalid character": {
+			target:    "www_1.example.com",
+			expectErr: true,
+		},
+		"label too long": {
+			target:    strings.Repeat("a", 64) + ".example.com",
+			expectErr: true,
+		},
+		"name too long": {
+			target:    strings.Repeat(strings.Repeat("a", 63)+".", 4) + "com",
+			expectErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateDomainListTarget(testCase.target)
+
+			if got, want := err != nil, testCase.expectErr; got != want {
+				t.Errorf("validateDomainListTarget(%q) err = %v, expected error: %t", testCase.target, err, want)
+			}
+		})
+	}
+}
+
+func TestParseDomainListTargets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		want    []string
+	}{
+		"empty": {
+			content: "",
+		},
+		"newline separated": {
+			content: "www.example.com\n.example.org\n",
+			want:    []string{"www.example.com", ".example.org"},
+		},
+		"comma separated": {
+			content: "www.example.com, .example.org,",
+			want:    []string{"www.example.com", ".example.org"},
+		},
+		"comments and blank lines": {
+			content: "# allowed domains\n\n  www.example.com  \r\n# .example.net\n.example.org",
+			want:    []string{"www.example.com", ".example.org"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := parseDomainListTargets(testCase.content)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
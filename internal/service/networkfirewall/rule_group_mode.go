@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Rules are deployed with their drop and reject actions rewritten to alert.
+	ruleGroupModeAlertOnly = "ALERT_ONLY"
+	// Rules are deployed as written.
+	ruleGroupModeEnforced = "ENFORCED"
+)
+
+func ruleGroupMode_Values() []string {
+	return []string{
+		ruleGroupModeAlertOnly,
+		ruleGroupModeEnforced,
+	}
+}
+
+var (
+	// Matches the action keyword at the start of a Suricata rule that blocks traffic.
+	// Commented out rules start with "#" and are left unchanged.
+	suricataBlockingActionRegexp = regexache.MustCompile(`(?m)^([ \t]*)(?:drop|reject)([ \t])`)
+)
+
+// alertOnlyRules rewrites the drop and reject actions in Suricata compatible rules to alert.
+func alertOnlyRules(rules string) string {
+	return suricataBlockingActionRegexp.ReplaceAllString(rules, "${1}alert${2}")
+}
+
+// ruleGroupRules returns the Suricata rules configured in rules with the rule group's mode applied.
+func ruleGroupRules(d *schema.ResourceData) string {
+	rules := d.Get("rules").(string)
+
+	if d.Get("mode").(string) == ruleGroupModeAlertOnly {
+		rules = alertOnlyRules(rules)
+	}
+
+	return rules
+}
+
+// customizeDiffRuleGroupMode plans the rule group's stateful rules with its mode applied,
+// so that switching between ALERT_ONLY and ENFORCED only requires changing the mode.
+func customizeDiffRuleGroupMode(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("mode").(string) != ruleGroupModeAlertOnly {
+		return nil
+	}
+
+	if v := d.Get(names.AttrType).(string); v != networkfirewall.RuleGroupTypeStateful {
+		return fmt.Errorf("mode %s is only supported for %s rule groups", ruleGroupModeAlertOnly, networkfirewall.RuleGroupTypeStateful)
+	}
+
+	if !d.NewValueKnown("rule_group") {
+		return nil
+	}
+
+	tfList, ok := d.Get("rule_group").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	if tfMapAtPath(tfList, "rules_source", "rules_source_list") != nil {
+		return fmt.Errorf("mode %s is not supported for domain list rule groups", ruleGroupModeAlertOnly)
+	}
+
+	if !setRuleGroupAlertOnly(tfList) {
+		return nil
+	}
+
+	return d.SetNew("rule_group", tfList)
+}
+
+// setRuleGroupAlertOnly rewrites the drop and reject actions of rule_group.rules_source's
+// rules_string and stateful_rule to alert.
+// The returned boolean reports whether any action was changed.
+func setRuleGroupAlertOnly(tfList []interface{}) bool {
+	rulesSource := tfMapAtPath(tfList, "rules_source")
+	if rulesSource == nil {
+		return false
+	}
+
+	changed := false
+
+	if v, ok := rulesSource["rules_string"].(string); ok && v != "" {
+		if rules := alertOnlyRules(v); rules != v {
+			rulesSource["rules_string"] = rules
+			changed = true
+		}
+	}
+
+	if v, ok := rulesSource["stateful_rule"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			switch tfMap[names.AttrAction] {
+			case networkfirewall.StatefulActionDrop, networkfirewall.StatefulActionReject:
+				tfMap[names.AttrAction] = networkfirewall.StatefulActionAlert
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
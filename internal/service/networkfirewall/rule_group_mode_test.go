@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAlertOnlyRules(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rules string
+		want  string
+	}{
+		"empty": {},
+		"drop": {
+			rules: `drop tls any any -> any any (tls.sni; content:"example.com"; sid:1;)`,
+			want:  `alert tls any any -> any any (tls.sni; content:"example.com"; sid:1;)`,
+		},
+		"reject": {
+			rules: `reject http any any -> any any (http.host; content:"example.com"; sid:1;)`,
+			want:  `alert http any any -> any any (http.host; content:"example.com"; sid:1;)`,
+		},
+		"pass and alert unchanged": {
+			rules: "pass ip any any -> any any (sid:1;)\nalert ip any any -> any any (sid:2;)",
+			want:  "pass ip any any -> any any (sid:1;)\nalert ip any any -> any any (sid:2;)",
+		},
+		"multiple rules": {
+			rules: "# block example.com\n  drop tls any any -> any any (sid:1;)\n\treject tcp any any -> any any (sid:2;)\n",
+			want:  "# block example.com\n  alert tls any any -> any any (sid:1;)\n\talert tcp any any -> any any (sid:2;)\n",
+		},
+		"commented out rule": {
+			rules: "#drop ip any any -> any any (sid:1;)\n# drop ip any any -> any any (sid:2;)",
+			want:  "#drop ip any any -> any any (sid:1;)\n# drop ip any any -> any any (sid:2;)",
+		},
+		"keyword in options": {
+			rules: `pass tls any any -> any any (msg:"drop reject"; sid:1;)`,
+			want:  `pass tls any any -> any any (msg:"drop reject"; sid:1;)`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := alertOnlyRules(testCase.rules); got != testCase.want {
+				t.Errorf("alertOnlyRules(%q) = %q, want %q", testCase.rules, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSetRuleGroupAlertOnly(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfList      []interface{}
+		want        []interface{}
+		wantChanged bool
+	}{
+		"no rule group": {},
+		"rules string": {
+			tfList: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": "drop ip any any -> any any (sid:1;)",
+				}},
+			}},
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": "alert ip any any -> any any (sid:1;)",
+				}},
+			}},
+			wantChanged: true,
+		},
+		"stateful rules": {
+			tfList: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"stateful_rule": []interface{}{
+						map[string]interface{}{"action": "DROP"},
+						map[string]interface{}{"action": "PASS"},
+						map[string]interface{}{"action": "REJECT"},
+					},
+				}},
+			}},
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"stateful_rule": []interface{}{
+						map[string]interface{}{"action": "ALERT"},
+						map[string]interface{}{"action": "PASS"},
+						map[string]interface{}{"action": "ALERT"},
+					},
+				}},
+			}},
+			wantChanged: true,
+		},
+		"unchanged": {
+			tfList: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": "alert ip any any -> any any (sid:1;)",
+					"stateful_rule": []interface{}{
+						map[string]interface{}{"action": "ALERT"},
+					},
+				}},
+			}},
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": "alert ip any any -> any any (sid:1;)",
+					"stateful_rule": []interface{}{
+						map[string]interface{}{"action": "ALERT"},
+					},
+				}},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotChanged := setRuleGroupAlertOnly(testCase.tfList)
+
+			if diff := cmp.Diff(testCase.tfList, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if gotChanged != testCase.wantChanged {
+				t.Errorf("changed = %t, want %t", gotChanged, testCase.wantChanged)
+			}
+		})
+	}
+}
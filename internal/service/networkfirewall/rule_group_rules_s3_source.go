@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// customizeDiffRuleGroupRulesS3Source reads the Suricata rules referenced by rules_s3_source
+// and plans them as the rule group's rules_string, so that changes to the S3 object are detected at plan time.
+func customizeDiffRuleGroupRulesS3Source(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const (
+		key = "rules_s3_source"
+	)
+	if !d.NewValueKnown(key) {
+		return nil
+	}
+
+	tfList, ok := d.Get(key).([]interface{})
+	if !ok || len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	rules, err := findRulesS3Object(ctx, meta.(*conns.AWSClient), tfList[0].(map[string]interface{}))
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", key, err)
+	}
+
+	ruleGroup, ok := d.Get("rule_group").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	ruleGroup, changed := setRuleGroupRulesString(ruleGroup, rules)
+	if !changed {
+		return nil
+	}
+
+	return d.SetNew("rule_group", ruleGroup)
+}
+
+// setRuleGroupRulesString sets rule_group.rules_source.rules_string, preserving the rest of the rule group.
+// The returned boolean reports whether the rules string was changed.
+func setRuleGroupRulesString(tfList []interface{}, rules string) ([]interface{}, bool) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		tfList = []interface{}{map[string]interface{}{}}
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	rulesSource := tfMapAtPath(tfList, "rules_source")
+	if rulesSource == nil {
+		rulesSource = map[string]interface{}{}
+		tfMap["rules_source"] = []interface{}{rulesSource}
+	}
+
+	if v, ok := rulesSource["rules_string"].(string); ok && v == rules {
+		return tfList, false
+	}
+
+	rulesSource["rules_string"] = rules
+
+	return tfList, true
+}
+
+// findRulesS3Object returns the contents of the S3 object referenced by a rules_s3_source block.
+func findRulesS3Object(ctx context.Context, client *conns.AWSClient, tfMap map[string]interface{}) (string, error) {
+	bucket, key := tfMap[names.AttrBucket].(string), tfMap[names.AttrKey].(string)
+	input := &s3.GetObjectInput{
+		Bucket: aws_sdkv2.String(bucket),
+		Key:    aws_sdkv2.String(key),
+	}
+
+	if v, ok := tfMap["version_id"].(string); ok && v != "" {
+		input.VersionId = aws_sdkv2.String(v)
+	}
+
+	output, err := client.S3Client(ctx).GetObject(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+	}
+	defer output.Body.Close()
+
+	b, err := io.ReadAll(output.Body)
+
+	if err != nil {
+		return "", fmt.Errorf("reading S3 Object (s3://%s/%s): %w", bucket, key, err)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSetRuleGroupRulesString(t *testing.T) {
+	t.Parallel()
+
+	const rules = `pass tls any any -> any any (tls.sni; content:"example.com"; sid:1;)`
+
+	testCases := map[string]struct {
+		tfList      []interface{}
+		want        []interface{}
+		wantChanged bool
+	}{
+		"no rule group": {
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": rules,
+				}},
+			}},
+			wantChanged: true,
+		},
+		"rule group without rules source": {
+			tfList: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{},
+				"stateful_rule_options": []interface{}{map[string]interface{}{
+					"rule_order": "STRICT_ORDER",
+				}},
+			}},
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": rules,
+				}},
+				"stateful_rule_options": []interface{}{map[string]interface{}{
+					"rule_order": "STRICT_ORDER",
+				}},
+			}},
+			wantChanged: true,
+		},
+		"changed rules": {
+			tfList: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": "drop ip any any -> any any (sid:1;)",
+				}},
+			}},
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": rules,
+				}},
+			}},
+			wantChanged: true,
+		},
+		"unchanged rules": {
+			tfList: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": rules,
+				}},
+			}},
+			want: []interface{}{map[string]interface{}{
+				"rules_source": []interface{}{map[string]interface{}{
+					"rules_string": rules,
+				}},
+			}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotChanged := setRuleGroupRulesString(testCase.tfList, rules)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			if gotChanged != testCase.wantChanged {
+				t.Errorf("changed = %t, want %t", gotChanged, testCase.wantChanged)
+			}
+		})
+	}
+}
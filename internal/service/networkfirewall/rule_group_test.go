@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -267,6 +269,7 @@ alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:
 					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "network-firewall", fmt.Sprintf("stateful-rulegroup/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, "capacity", "100"),
+					resource.TestCheckResourceAttr(resourceName, "mode", "ENFORCED"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, networkfirewall.RuleGroupTypeStateful),
 					resource.TestCheckResourceAttr(resourceName, "rules", rules),
@@ -287,6 +290,121 @@ alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_detectExternalChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	var updateToken string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_detectExternalChanges(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrSet(resourceName, "consumed_capacity"),
+					resource.TestCheckResourceAttr(resourceName, "detect_external_changes", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "update_token"),
+					testAccCheckRuleGroupUpdateExternally(ctx, &ruleGroup, &updateToken),
+				),
+			},
+			{
+				// The external update leaves the rules unchanged, so only the update token differs.
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceName, "update_token", &updateToken),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"detect_external_changes",
+				},
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_mode(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup1, ruleGroup2, ruleGroup3 networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	rules := `drop tls any any -> any any (tls.sni; content:"example.com"; sid:1;)
+reject http any any -> any any (http.host; content:"example.com"; sid:2;)
+pass tls any any -> any any (tls.sni; content:"example.net"; sid:3;)`
+	alertOnlyRules := `alert tls any any -> any any (tls.sni; content:"example.com"; sid:1;)
+alert http any any -> any any (http.host; content:"example.com"; sid:2;)
+pass tls any any -> any any (tls.sni; content:"example.net"; sid:3;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_mode(rName, rules, "ALERT_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup1),
+					resource.TestCheckResourceAttr(resourceName, "mode", "ALERT_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", alertOnlyRules),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mode"},
+			},
+			{
+				Config: testAccRuleGroupConfig_mode(rName, rules, "ENFORCED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup2),
+					testAccCheckRuleGroupNotRecreated(&ruleGroup1, &ruleGroup2),
+					resource.TestCheckResourceAttr(resourceName, "mode", "ENFORCED"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_modeRules(rName, rules, "ALERT_ONLY"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup3),
+					testAccCheckRuleGroupNotRecreated(&ruleGroup2, &ruleGroup3),
+					resource.TestCheckResourceAttr(resourceName, "mode", "ALERT_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "rules", rules),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", alertOnlyRules),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_Mode_stateless(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_modeStateless(rName),
+				ExpectError: regexache.MustCompile(`mode ALERT_ONLY is only supported for STATEFUL rule groups`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_statefulRuleOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -504,6 +622,136 @@ func TestAccNetworkFirewallRuleGroup_updateRulesSourceList(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_RulesSourceList_targetsValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleGroupConfig_sourceListTargets(rName, 100, `"*.example.com"`),
+				ExpectError: regexache.MustCompile(`wildcards aren't supported`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_sourceListTargets(rName, 100, `"www..example.com"`),
+				ExpectError: regexache.MustCompile(`must be 1-63 alphanumeric characters`),
+			},
+			{
+				Config:      testAccRuleGroupConfig_sourceListTargets(rName, 1, `"test.example.com", ".example.org"`),
+				ExpectError: regexache.MustCompile(`domain list has 2 targets, which exceeds the rule group capacity of 1`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_RulesSourceList_targetsSource(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_sourceListTargetsS3(rName, "test.example.com\n.example.org\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test.example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", ".example.org"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule_group.0.rules_source.0.rules_source_list.0.targets_source"},
+			},
+			{
+				Config: testAccRuleGroupConfig_sourceListTargetsS3(rName, "# updated\ntest.example.com\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_rulesS3Source(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+	rules1 := `alert http any any -> any any (http_response_line; content:"403 Forbidden"; sid:1;)`
+	rules2 := `alert http any any -> any any (http_response_line; content:"401 Unauthorized"; sid:2;)`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_rulesS3Source(rName, rules1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rules_s3_source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules1),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rules_s3_source"},
+			},
+			{
+				Config: testAccRuleGroupConfig_rulesS3Source(rName, rules2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_string", rules2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkFirewallRuleGroup_RulesSourceList_targetsSourceSSM(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_sourceListTargetsSSM(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", "test.example.com"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "rule_group.0.rules_source.0.rules_source_list.0.targets.*", ".example.org"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_rulesSourceAndRuleVariables(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -993,6 +1241,45 @@ func TestAccNetworkFirewallRuleGroup_encryptionConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccNetworkFirewallRuleGroup_encryptionConfigurationKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var ruleGroup networkfirewall.DescribeRuleGroupOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupConfig_encryptionConfigurationKey(rName, "aws_kms_key.test1.arn"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_configuration.0.key_id", "aws_kms_key.test1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccRuleGroupConfig_encryptionConfigurationKey(rName, "aws_kms_key.test2.arn"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRuleGroupExists(ctx, resourceName, &ruleGroup),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_configuration.0.key_id", "aws_kms_key.test2", names.AttrARN),
+				),
+			},
+			{
+				Config:      testAccRuleGroupConfig_encryptionConfigurationKey(rName, "aws_kms_key.asymmetric.arn"),
+				ExpectError: regexache.MustCompile(`Network Firewall requires a SYMMETRIC_DEFAULT key for ENCRYPT_DECRYPT`),
+			},
+		},
+	})
+}
+
 func TestAccNetworkFirewallRuleGroup_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var ruleGroup networkfirewall.DescribeRuleGroupOutput
@@ -1068,6 +1355,29 @@ func testAccCheckRuleGroupExists(ctx context.Context, n string, v *networkfirewa
 	}
 }
 
+// testAccCheckRuleGroupUpdateExternally updates the rule group with its current definition,
+// which changes the update token without changing the rules.
+func testAccCheckRuleGroupUpdateExternally(ctx context.Context, v *networkfirewall.DescribeRuleGroupOutput, updateToken *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NetworkFirewallConn(ctx)
+
+		output, err := conn.UpdateRuleGroupWithContext(ctx, &networkfirewall.UpdateRuleGroupInput{
+			RuleGroup:    v.RuleGroup,
+			RuleGroupArn: v.RuleGroupResponse.RuleGroupArn,
+			Type:         v.RuleGroupResponse.Type,
+			UpdateToken:  v.UpdateToken,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*updateToken = aws.StringValue(output.UpdateToken)
+
+		return nil
+	}
+}
+
 func testAccCheckRuleGroupNotRecreated(i, j *networkfirewall.DescribeRuleGroupOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if before, after := aws.StringValue(i.RuleGroupResponse.RuleGroupId), aws.StringValue(j.RuleGroupResponse.RuleGroupId); before != after {
@@ -1106,6 +1416,114 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName)
 }
 
+func testAccRuleGroupConfig_sourceListTargets(rName string, capacity int, targets string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = %[2]d
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = [%[3]s]
+      }
+    }
+  }
+}
+`, rName, capacity, targets)
+}
+
+func testAccRuleGroupConfig_sourceListTargetsS3(rName, content string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "domains.txt"
+  content = "%[2]s"
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+
+        targets_source {
+          s3_bucket = aws_s3_object.test.bucket
+          s3_key    = aws_s3_object.test.key
+        }
+      }
+    }
+  }
+}
+`, rName, content)
+}
+
+func testAccRuleGroupConfig_rulesS3Source(rName, rules string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "rules.suricata"
+  content = %[2]q
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rules_s3_source {
+    bucket = aws_s3_object.test.bucket
+    key    = aws_s3_object.test.key
+  }
+}
+`, rName, rules)
+}
+
+func testAccRuleGroupConfig_sourceListTargetsSSM(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name  = %[1]q
+  type  = "StringList"
+  value = "test.example.com,.example.org"
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+
+        targets_source {
+          ssm_parameter_name = aws_ssm_parameter.test.name
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_referenceSets(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_managed_prefix_list" "example1" {
@@ -1602,6 +2020,27 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_detectExternalChanges(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity                = 100
+  name                    = %[1]q
+  type                    = "STATEFUL"
+  detect_external_changes = true
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = ["test.example.com"]
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_sourceString(rName, rules string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1618,6 +2057,70 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, rules)
 }
 
+func testAccRuleGroupConfig_mode(rName, rules, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+  mode     = %[3]q
+
+  rule_group {
+    rules_source {
+      rules_string = %[2]q
+    }
+  }
+}
+`, rName, rules, mode)
+}
+
+func testAccRuleGroupConfig_modeRules(rName, rules, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+  mode     = %[3]q
+  rules    = %[2]q
+}
+`, rName, rules, mode)
+}
+
+func testAccRuleGroupConfig_modeStateless(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATELESS"
+  mode     = "ALERT_ONLY"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:drop"]
+
+            match_attributes {
+              source {
+                address_definition = "1.2.3.4/32"
+              }
+
+              destination {
+                address_definition = "124.1.1.5/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName)
+}
+
 func testAccRuleGroupConfig_statefulOptions(rName, rules, ruleOrder string) string {
 	return fmt.Sprintf(`
 resource "aws_networkfirewall_rule_group" "test" {
@@ -1760,6 +2263,45 @@ resource "aws_networkfirewall_rule_group" "test" {
 `, rName, generatedRulesType)
 }
 
+func testAccRuleGroupConfig_encryptionConfigurationKey(rName, keyID string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test1" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "test2" {
+  deletion_window_in_days = 7
+}
+
+resource "aws_kms_key" "asymmetric" {
+  customer_master_key_spec = "RSA_2048"
+  deletion_window_in_days  = 7
+  key_usage                = "ENCRYPT_DECRYPT"
+}
+
+resource "aws_networkfirewall_rule_group" "test" {
+  capacity = 100
+  name     = %[1]q
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = ["test.example.com"]
+      }
+    }
+  }
+
+  encryption_configuration {
+    key_id = %[2]s
+    type   = "CUSTOMER_KMS"
+  }
+}
+`, rName, keyID)
+}
+
 // The KMS key resource must stay in state while removing encryption configuration. If not
 // (ie. using the _basic config), the KMS key is deleted before the rule group is updated,
 // leaving the group in a "misconfigured" state. This causes update to fail with:
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_networkfirewall_rule_groups")
+func DataSourceRuleGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(networkfirewall.ResourceManagedType_Values(), false),
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(networkfirewall.ResourceManagedStatus_Values(), false),
+			},
+			names.AttrTags: tftags.TagsSchema(),
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(networkfirewall.RuleGroupType_Values(), false),
+			},
+		},
+	}
+}
+
+func dataSourceRuleGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).NetworkFirewallConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &networkfirewall.ListRuleGroupsInput{}
+	if v, ok := d.GetOk("managed_type"); ok {
+		input.ManagedType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("scope"); ok {
+		input.Scope = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk(names.AttrType); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	ruleGroups, err := findRuleGroups(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading NetworkFirewall Rule Groups: %s", err)
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	var arns, resourceNames []string
+
+	for _, v := range ruleGroups {
+		arn := aws.StringValue(v.Arn)
+
+		if len(tagsToMatch) > 0 {
+			tags, err := listTags(ctx, conn, arn)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for NetworkFirewall Rule Group (%s): %s", arn, err)
+			}
+
+			if !tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+		resourceNames = append(resourceNames, aws.StringValue(v.Name))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set("names", resourceNames)
+
+	return diags
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNetworkFirewallRuleGroupsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_networkfirewall_rule_group.test1"
+	dataSourceName1 := "data.aws_networkfirewall_rule_groups.tags"
+	dataSourceName2 := "data.aws_networkfirewall_rule_groups.stateful"
+	dataSourceName3 := "data.aws_networkfirewall_rule_groups.stateless"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkFirewallServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleGroupsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName1, "arns.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName1, "names.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName2, "arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName2, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName2, "names.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName2, "names.0", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName3, "arns.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName3, "names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleGroupsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkfirewall_rule_group" "test1" {
+  capacity = 100
+  name     = "%[1]s-1"
+  type     = "STATEFUL"
+
+  rule_group {
+    rules_source {
+      rules_source_list {
+        generated_rules_type = "ALLOWLIST"
+        target_types         = ["HTTP_HOST"]
+        targets              = ["test.example.com"]
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkfirewall_rule_group" "test2" {
+  capacity = 100
+  name     = "%[1]s-2"
+  type     = "STATELESS"
+
+  rule_group {
+    rules_source {
+      stateless_rules_and_custom_actions {
+        stateless_rule {
+          priority = 1
+
+          rule_definition {
+            actions = ["aws:drop"]
+
+            match_attributes {
+              destination {
+                address_definition = "1.2.3.4/32"
+              }
+
+              source {
+                address_definition = "124.1.1.5/32"
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_networkfirewall_rule_groups" "tags" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkfirewall_rule_group.test1, aws_networkfirewall_rule_group.test2]
+}
+
+data "aws_networkfirewall_rule_groups" "stateful" {
+  scope = "ACCOUNT"
+  type  = "STATEFUL"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkfirewall_rule_group.test1, aws_networkfirewall_rule_group.test2]
+}
+
+data "aws_networkfirewall_rule_groups" "stateless" {
+  type = "STATELESS"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkfirewall_rule_group.test1, aws_networkfirewall_rule_group.test2]
+}
+`, rName)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfirewall
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// AsyncWaiters returns the waiters for resources of this service package created with `async_create = true`, keyed by resource type.
+func (p *servicePackage) AsyncWaiters(context.Context) map[string]conns.AsyncWaiter {
+	return map[string]conns.AsyncWaiter{
+		"aws_networkfirewall_firewall": func(ctx context.Context, client *conns.AWSClient, id string, timeout time.Duration) error {
+			_, err := waitFirewallCreated(ctx, client.NetworkFirewallConn(ctx), timeout, id)
+
+			return err
+		},
+	}
+}
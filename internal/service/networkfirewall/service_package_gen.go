@@ -29,14 +29,42 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceFirewall,
 			TypeName: "aws_networkfirewall_firewall",
 		},
+		{
+			Factory:  DataSourceFirewallHealth,
+			TypeName: "aws_networkfirewall_firewall_health",
+		},
+		{
+			Factory:  DataSourceFirewallManagerPolicyScope,
+			TypeName: "aws_networkfirewall_firewall_manager_policy_scope",
+		},
+		{
+			Factory:  DataSourceFirewallPolicies,
+			TypeName: "aws_networkfirewall_firewall_policies",
+		},
 		{
 			Factory:  DataSourceFirewallPolicy,
 			TypeName: "aws_networkfirewall_firewall_policy",
 		},
+		{
+			Factory:  DataSourceFirewallPolicyAnalysis,
+			TypeName: "aws_networkfirewall_firewall_policy_analysis",
+		},
+		{
+			Factory:  DataSourceFirewallPolicyEvaluation,
+			TypeName: "aws_networkfirewall_firewall_policy_evaluation",
+		},
+		{
+			Factory:  DataSourceLoggingDestination,
+			TypeName: "aws_networkfirewall_logging_destination",
+		},
 		{
 			Factory:  DataSourceFirewallResourcePolicy,
 			TypeName: "aws_networkfirewall_resource_policy",
 		},
+		{
+			Factory:  DataSourceRuleGroups,
+			TypeName: "aws_networkfirewall_rule_groups",
+		},
 	}
 }
 
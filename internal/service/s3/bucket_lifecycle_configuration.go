@@ -5,6 +5,7 @@ package s3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"slices"
@@ -46,6 +47,8 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 			Update: schema.DefaultTimeout(3 * time.Minute),
 		},
 
+		CustomizeDiff: resourceBucketLifecycleConfigurationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrBucket: {
 				Type:         schema.TypeString,
@@ -275,10 +278,6 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 		return conn.PutBucketLifecycleConfiguration(ctx, input)
 	}, errCodeNoSuchBucket)
 
-	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
-		err = errDirectoryBucket(err)
-	}
-
 	if err != nil {
 		return diag.Errorf("creating S3 Bucket (%s) Lifecycle Configuration: %s", bucket, err)
 	}
@@ -426,6 +425,108 @@ func resourceBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.R
 	return nil
 }
 
+func resourceBucketLifecycleConfigurationCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// This CustomizeDiff acts as a plan-time validation to prevent MalformedXML errors
+	// and to catch filter arguments that would otherwise be silently dropped.
+	directoryBucket := isDirectoryBucket(d.Get(names.AttrBucket).(string))
+
+	for i, ruleRaw := range d.Get("rule").([]interface{}) {
+		rule, ok := ruleRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if directoryBucket {
+			if err := validateDirectoryBucketLifecycleRule(rule); err != nil {
+				return fmt.Errorf("rule.%d: %w", i, err)
+			}
+		}
+
+		filters, ok := rule[names.AttrFilter].([]interface{})
+		if !ok || len(filters) == 0 || filters[0] == nil {
+			continue
+		}
+
+		if err := validateLifecycleRuleFilter(filters[0].(map[string]interface{})); err != nil {
+			return fmt.Errorf("rule.%d.filter: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateLifecycleRuleFilter verifies that at most one filter criterion is specified
+// and that object size ranges are non-empty.
+func validateLifecycleRuleFilter(m map[string]interface{}) error {
+	var criteria []string
+
+	if v, ok := m["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		criteria = append(criteria, "and")
+
+		and := v[0].(map[string]interface{})
+		greaterThan, lessThan := and["object_size_greater_than"].(int), and["object_size_less_than"].(int)
+
+		if greaterThan > 0 && lessThan > 0 && greaterThan >= lessThan {
+			return fmt.Errorf("and.object_size_greater_than (%d) must be less than and.object_size_less_than (%d)", greaterThan, lessThan)
+		}
+	}
+
+	for _, k := range []string{"object_size_greater_than", "object_size_less_than"} {
+		if v, ok := m[k].(string); ok && v != "" {
+			criteria = append(criteria, k)
+		}
+	}
+
+	if v, ok := m[names.AttrPrefix].(string); ok && v != "" {
+		criteria = append(criteria, names.AttrPrefix)
+	}
+
+	if v, ok := m["tag"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		criteria = append(criteria, "tag")
+	}
+
+	// Per AWS S3 API, "A Filter must have exactly one of Prefix, Tag, or And specified".
+	// Multiple criteria, e.g. an object size range, must be combined in an "and" block.
+	if len(criteria) > 1 {
+		return fmt.Errorf("only one of %s can be specified; use an and block to combine criteria", strings.Join(criteria, ", "))
+	}
+
+	return nil
+}
+
+// validateDirectoryBucketLifecycleRule verifies that a rule only uses the lifecycle actions and
+// filter criteria supported by S3 Express One Zone directory buckets.
+func validateDirectoryBucketLifecycleRule(m map[string]interface{}) error {
+	for _, k := range []string{"noncurrent_version_expiration", "noncurrent_version_transition", "transition"} {
+		switch v := m[k].(type) {
+		case []interface{}:
+			if len(v) > 0 {
+				return fmt.Errorf("%s is not supported for directory buckets", k)
+			}
+		case *schema.Set:
+			if v.Len() > 0 {
+				return fmt.Errorf("%s is not supported for directory buckets", k)
+			}
+		}
+	}
+
+	if v, ok := m[names.AttrFilter].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		filter := v[0].(map[string]interface{})
+
+		if v, ok := filter["tag"].([]interface{}); ok && len(v) > 0 {
+			return errors.New("filter.tag is not supported for directory buckets")
+		}
+
+		if v, ok := filter["and"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			if v, ok := v[0].(map[string]interface{})[names.AttrTags].(map[string]interface{}); ok && len(v) > 0 {
+				return errors.New("filter.and.tags is not supported for directory buckets")
+			}
+		}
+	}
+
+	return nil
+}
+
 // suppressMissingFilterConfigurationBlock suppresses the diff that results from an omitted
 // filter configuration block and one returned from the S3 API.
 // To work around the issue, https://github.com/hashicorp/terraform-plugin-sdk/issues/743,
@@ -324,6 +324,30 @@ func TestAccS3BucketLifecycleConfiguration_Filter_ObjectSizeRangeAndPrefix(t *te
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_Filter_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	currTime := time.Now()
+	date := time.Date(currTime.Year(), currTime.Month()+1, currTime.Day(), 0, 0, 0, 0, time.UTC).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_filterObjectSizeRange(rName, date, 64000, 500),
+				ExpectError: regexache.MustCompile(`and.object_size_greater_than \(64000\) must be less than and.object_size_less_than \(500\)`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_filterObjectSizeGreaterThanConflictsWithPrefix(rName, "prefix/"),
+				ExpectError: regexache.MustCompile(`only one of object_size_greater_than, prefix can be specified`),
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLifecycleConfiguration_disableRule(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1052,6 +1076,42 @@ func TestAccS3BucketLifecycleConfiguration_Update_filterWithAndToFilterWithPrefi
 func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_directoryBucket(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_directory_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						"expiration.#":      "1",
+						"expiration.0.days": "365",
+						"filter.#":          "1",
+						"filter.0.prefix":   "logs/",
+						names.AttrID:        rName,
+						names.AttrStatus:    tfs3.LifecycleRuleStatusEnabled,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_directoryBucketTransition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.ParallelTest(t, resource.TestCase{
 		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
@@ -1060,8 +1120,8 @@ func TestAccS3BucketLifecycleConfiguration_directoryBucket(t *testing.T) {
 		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucket(rName),
-				ExpectError: regexache.MustCompile(`directory buckets are not supported`),
+				Config:      testAccBucketLifecycleConfigurationConfig_directoryBucketTransition(rName),
+				ExpectError: regexache.MustCompile(`transition is not supported for directory buckets`),
 			},
 		},
 	})
@@ -1705,6 +1765,32 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 }`, rName, prefix)
 }
 
+func testAccBucketLifecycleConfigurationConfig_filterObjectSizeGreaterThanConflictsWithPrefix(rName, prefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id = %[1]q
+
+    expiration {
+      days = 90
+    }
+
+    filter {
+      object_size_greater_than = 300
+      prefix                   = %[2]q
+    }
+
+    status = "Enabled"
+  }
+}`, rName, prefix)
+}
+
 func testAccBucketLifecycleConfigurationConfig_filterPrefix(rName, prefix string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -1786,6 +1872,7 @@ resource "aws_s3_directory_bucket" "test" {
 
 resource "aws_s3_bucket_lifecycle_configuration" "test" {
   bucket = aws_s3_directory_bucket.test.bucket
+
   rule {
     id     = %[1]q
     status = "Enabled"
@@ -1793,6 +1880,36 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
     expiration {
       days = 365
     }
+
+    filter {
+      prefix = "logs/"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccBucketLifecycleConfigurationConfig_directoryBucketTransition(rName string) string {
+	return acctest.ConfigCompose(testAccDirectoryBucketConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_bucket" "test" {
+  bucket = local.bucket
+
+  location {
+    name = local.location_name
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_directory_bucket.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
   }
 }
 `, rName))
@@ -56,6 +56,7 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	MultipartUploadETag                   = multipartUploadETag
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
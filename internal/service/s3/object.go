@@ -6,6 +6,9 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -139,12 +142,13 @@ func resourceObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption.
+				// The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
-				Optional:      true,
-				Computed:      true,
-				ConflictsWith: []string{names.AttrKMSKeyID},
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{names.AttrKMSKeyID},
+				DiffSuppressFunc: suppressMultipartUploadETag,
 			},
 			names.AttrForceDestroy: {
 				Type:     schema.TypeBool,
@@ -238,6 +242,22 @@ func resourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"uploaded_part_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -443,6 +463,8 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 	d.SetId(key)
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrKey, key)
+	d.Set("upload_concurrency", manager.DefaultUploadConcurrency)
+	d.Set("upload_part_size", int(manager.DefaultUploadPartSize))
 
 	return []*schema.ResourceData{d}, nil
 }
@@ -585,7 +607,21 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	// The upload settings aren't set in the state of objects created before they were added.
+	concurrency := d.Get("upload_concurrency").(int)
+	if concurrency == 0 {
+		concurrency = manager.DefaultUploadConcurrency
+	}
+	partSize := d.Get("upload_part_size").(int)
+	if partSize == 0 {
+		partSize = int(manager.DefaultUploadPartSize)
+	}
+
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		// Bodies larger than the part size are uploaded in parts, concurrently.
+		u.Concurrency = concurrency
+		u.PartSize = int64(partSize)
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -594,6 +630,10 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	if d.IsNewResource() {
 		d.SetId(d.Get(names.AttrKey).(string))
 	}
+	d.Set("upload_concurrency", concurrency)
+	d.Set("upload_part_size", partSize)
+	// Changing upload_part_size alone doesn't upload the object again, so record the part size the object was uploaded with.
+	d.Set("uploaded_part_size", partSize)
 
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
@@ -631,6 +671,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
+		if err := d.SetNewComputed("uploaded_part_size"); err != nil {
+			return err
+		}
+
 		return d.SetNewComputed("version_id")
 	}
 
@@ -642,6 +686,102 @@ func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
+// suppressMultipartUploadETag suppresses the diff between a configured MD5 of the source file and
+// the ETag of an object that was uploaded in multiple parts, which isn't an MD5 of the object data.
+// The diff is suppressed only if the source file, split with the part size the object was uploaded with, still produces the stored multipart ETag.
+func suppressMultipartUploadETag(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" || !strings.Contains(old, "-") {
+		return false
+	}
+
+	source, ok := d.GetOk(names.AttrSource)
+	if !ok {
+		return false
+	}
+
+	path, err := homedir.Expand(source.(string))
+	if err != nil {
+		return false
+	}
+
+	// Objects uploaded before uploaded_part_size was recorded, or imported, fall back to the configured part size.
+	partSize := d.Get("uploaded_part_size").(int)
+	if partSize == 0 {
+		partSize = d.Get("upload_part_size").(int)
+	}
+	if partSize == 0 {
+		partSize = int(manager.DefaultUploadPartSize)
+	}
+
+	etag, err := multipartUploadETag(path, int64(partSize))
+	if err != nil {
+		log.Printf("[WARN] Error computing S3 object source (%s) multipart ETag: %s", path, err)
+		return false
+	}
+
+	return etag == old
+}
+
+// multipartUploadETag returns the ETag that S3 assigns to the file at path when it is uploaded
+// by the upload manager with the specified part size, i.e. the hex-encoded MD5 of the concatenated
+// part MD5s followed by "-" and the number of parts.
+// Files no larger than a single part are uploaded with PutObject and their ETag is the MD5 of the file.
+func multipartUploadETag(path string, partSize int64) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	fi, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	if partSize <= 0 {
+		partSize = manager.DefaultUploadPartSize
+	}
+
+	// The upload manager increases the part size to stay within the maximum number of parts.
+	size := fi.Size()
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = size/int64(manager.MaxUploadParts) + 1
+	}
+
+	if size <= partSize {
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(hash.Sum(nil)), nil
+	}
+
+	var sums []byte
+	var parts int
+	for {
+		hash := md5.New()
+		n, err := io.CopyN(hash, file, partSize)
+
+		if n > 0 {
+			sums = append(sums, hash.Sum(nil)...)
+			parts++
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sum := md5.Sum(sums)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
 func hasObjectContentChanges(d sdkv2.ResourceDiffer) bool {
 	for _, key := range []string{
 		"bucket_key_enabled",
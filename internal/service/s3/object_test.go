@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -107,6 +109,54 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestMultipartUploadETag(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "source")
+	if err := os.WriteFile(path, []byte("abcdefghij"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		partSize int64
+		want     string
+	}{
+		{
+			name:     "single part",
+			partSize: 10,
+			want:     "a925576942e94b2ef57a066101b48876",
+		},
+		{
+			name:     "equal parts",
+			partSize: 5,
+			want:     "8e18a6d3619b553c27c7028ea9067e05-2",
+		},
+		{
+			name:     "short last part",
+			partSize: 4,
+			want:     "446feba4c1b5cc7ad93bf4d44a0e36ac-3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.MultipartUploadETag(path, testCase.partSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if want := testCase.want; got != want {
+				t.Errorf("MultipartUploadETag(%d) = %v, want %v", testCase.partSize, got, want)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -153,6 +203,8 @@ func TestAccS3Object_basic(t *testing.T) {
 					resource.TestCheckNoResourceAttr(resourceName, "source_hash"),
 					resource.TestCheckResourceAttr(resourceName, "storage_class", "STANDARD"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "5"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
 					resource.TestCheckResourceAttr(resourceName, "version_id", ""),
 					resource.TestCheckResourceAttr(resourceName, "website_redirect", ""),
 				),
@@ -161,7 +213,7 @@ func TestAccS3Object_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -274,7 +326,7 @@ func TestAccS3Object_source(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -304,7 +356,7 @@ func TestAccS3Object_content(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrContent, "content_base64", names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrContent, "content_base64", names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -337,13 +389,62 @@ func TestAccS3Object_etagEncryption(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// 12 MiB is uploaded as 3 parts of at most 5 MiB.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 12*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 5242880, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_part_size", "5242880"),
+				),
+			},
+			{
+				// The configured MD5 doesn't match the multipart ETag but the source is unchanged.
+				Config:   testAccObjectConfig_multipartUpload(rName, source, 5242880, 2),
+				PlanOnly: true,
+			},
+			{
+				// Changing only the upload settings doesn't upload the object again.
+				Config: testAccObjectConfig_multipartUpload(rName, source, 6291456, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "4"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "6291456"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_part_size", "5242880"),
+				),
+			},
+			{
+				// The stored ETag is still checked against the part size the object was uploaded with.
+				Config:   testAccObjectConfig_multipartUpload(rName, source, 6291456, 4),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccS3Object_contentBase64(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -415,7 +516,7 @@ func TestAccS3Object_sourceHashTrigger(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrContent, "content_base64", names.AttrForceDestroy, names.AttrSource, "source_hash"},
+				ImportStateVerifyIgnore: []string{names.AttrContent, "content_base64", names.AttrForceDestroy, names.AttrSource, "source_hash", "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -476,7 +577,7 @@ func TestAccS3Object_nonVersioned(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
 			},
 		},
@@ -526,7 +627,7 @@ func TestAccS3Object_updates(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
 			},
 		},
@@ -619,7 +720,7 @@ func TestAccS3Object_updatesWithVersioning(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/updateable-key", rName),
 			},
 		},
@@ -694,7 +795,7 @@ func TestAccS3Object_kms(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -728,7 +829,7 @@ func TestAccS3Object_sse(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, names.AttrSource, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -780,7 +881,7 @@ func TestAccS3Object_acl(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"acl", names.AttrContent, names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{"acl", names.AttrContent, names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -828,7 +929,7 @@ func TestAccS3Object_metadata(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -891,7 +992,7 @@ func TestAccS3Object_storageClass(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrContent, names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrContent, names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -960,7 +1061,7 @@ func TestAccS3Object_tags(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrContent, names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrContent, names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -1029,7 +1130,7 @@ func TestAccS3Object_tagsLeadingSingleSlash(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrContent, names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrContent, names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -1185,7 +1286,7 @@ func TestAccS3Object_tags_EmptyTag_OnCreate(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -1226,7 +1327,7 @@ func TestAccS3Object_tags_EmptyTag_OnUpdate_Add(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -1266,7 +1367,7 @@ func TestAccS3Object_tags_EmptyTag_OnUpdate_Replace(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/%s", rName, key),
 			},
 		},
@@ -1301,7 +1402,7 @@ func TestAccS3Object_DefaultTags_providerOnly(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 		},
@@ -1925,7 +2026,7 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_crc32", names.AttrContent, names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_crc32", names.AttrContent, names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
 			},
 			{
@@ -2037,7 +2138,7 @@ func TestAccS3Object_directoryBucket(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "override_provider"},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "override_provider", "uploaded_part_size"},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					rs, ok := s.RootModule().Resources[resourceName]
 					if !ok {
@@ -2127,7 +2228,7 @@ func TestAccS3Object_prefix(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrForceDestroy},
+				ImportStateVerifyIgnore: []string{names.AttrForceDestroy, "uploaded_part_size"},
 				ImportStateId:           fmt.Sprintf("s3://%s/pfx/", rName),
 			},
 		},
@@ -2603,6 +2704,23 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source string, partSize, concurrency int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket             = aws_s3_bucket.test.bucket
+  key                = "test-key"
+  source             = %[2]q
+  etag               = filemd5(%[2]q)
+  upload_part_size   = %[3]d
+  upload_concurrency = %[4]d
+}
+`, rName, source, partSize, concurrency)
+}
+
 func testAccObjectConfig_contentBase64(rName string, contentBase64 string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -5,6 +5,8 @@ package s3
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -58,6 +60,38 @@ func dataSourceObjects() *schema.Resource {
 				Optional: true,
 				Default:  1000,
 			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"storage_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"owners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -135,6 +169,7 @@ func dataSourceObjectsRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	var nKeys int64
 	var commonPrefixes, keys, owners []string
+	var objects []interface{}
 	var requestCharged string
 
 	pages := s3.NewListObjectsV2Paginator(conn, input)
@@ -158,6 +193,7 @@ pageLoop:
 			}
 
 			keys = append(keys, aws.ToString(v.Key))
+			objects = append(objects, flattenObject(v))
 
 			if v := v.Owner; v != nil {
 				owners = append(owners, aws.ToString(v.ID))
@@ -170,8 +206,28 @@ pageLoop:
 	d.SetId(bucket)
 	d.Set("common_prefixes", commonPrefixes)
 	d.Set("keys", keys)
+	d.Set("objects", objects)
 	d.Set("owners", owners)
 	d.Set("request_charged", requestCharged)
 
 	return diags
 }
+
+func flattenObject(apiObject types.Object) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"etag":          strings.Trim(aws.ToString(apiObject.ETag), `"`),
+		names.AttrKey:   aws.ToString(apiObject.Key),
+		"size":          aws.ToInt64(apiObject.Size),
+		"storage_class": apiObject.StorageClass,
+	}
+
+	if v := apiObject.LastModified; v != nil {
+		tfMap["last_modified"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = aws.ToString(v.ID)
+	}
+
+	return tfMap
+}
@@ -29,6 +29,13 @@ func TestAccS3ObjectsDataSource_basic(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.#", "3"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.key", dataSourceName, "keys.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.etag"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.last_modified"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.owner", ""),
+					resource.TestCheckResourceAttrSet(dataSourceName, "objects.0.size"),
+					resource.TestCheckResourceAttr(dataSourceName, "objects.0.storage_class", "STANDARD"),
 					resource.TestCheckResourceAttr(dataSourceName, "owners.#", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "request_charged", ""),
 				),
@@ -213,6 +220,7 @@ func TestAccS3ObjectsDataSource_fetchOwner(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceName, "common_prefixes.#", "0"),
 					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "3"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "objects.0.owner", dataSourceName, "owners.0"),
 					resource.TestCheckResourceAttr(dataSourceName, "owners.#", "3"),
 				),
 			},
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -69,24 +70,28 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", envvar.AssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(envvar.AssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRole = append(conf.AssumeRole, assumeRole)
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -33,6 +33,8 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// PropagateAtLaunch is whether default tags applied to Auto Scaling Groups are propagated to launched instances.
+	PropagateAtLaunch bool
 }
 
 // IgnoreConfig contains various options for removing resource tags.